@@ -4,8 +4,15 @@ import (
 	"context"
 	_ "embed"
 	"fmt"
+	"go/token"
+	"maps"
+	"path"
+	"regexp"
 	"slices"
+	"strconv"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"buf.build/go/bufplugin/check"
 	"buf.build/go/bufplugin/check/checkutil"
@@ -13,6 +20,7 @@ import (
 	"buf.build/go/bufplugin/info"
 	"buf.build/go/bufplugin/option"
 	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
 )
 
 var (
@@ -25,13 +33,40 @@ func main() {
 }
 
 const (
-	ruleIDPackageNoLanguageReservedKeywords = "PLUGIN_PACKAGE_NO_LANGUAGE_RESERVED_KEYWORDS"
-	ruleIDFieldNoLanguageReservedKeywords   = "PLUGIN_FIELD_NO_LANGUAGE_RESERVED_KEYWORDS"
+	ruleIDPackageNoLanguageReservedKeywords   = "PLUGIN_PACKAGE_NO_LANGUAGE_RESERVED_KEYWORDS"
+	ruleIDFieldNoLanguageReservedKeywords     = "PLUGIN_FIELD_NO_LANGUAGE_RESERVED_KEYWORDS"
+	ruleIDMessageNoLanguageReservedKeywords   = "PLUGIN_MESSAGE_NO_LANGUAGE_RESERVED_KEYWORDS"
+	ruleIDEnumNoLanguageReservedKeywords      = "PLUGIN_ENUM_NO_LANGUAGE_RESERVED_KEYWORDS"
+	ruleIDEnumValueNoLanguageReservedKeywords = "PLUGIN_ENUM_VALUE_NO_LANGUAGE_RESERVED_KEYWORDS"
+	ruleIDServiceNoLanguageReservedKeywords   = "PLUGIN_SERVICE_NO_LANGUAGE_RESERVED_KEYWORDS"
+	ruleIDMethodNoLanguageReservedKeywords    = "PLUGIN_METHOD_NO_LANGUAGE_RESERVED_KEYWORDS"
+	ruleIDOneofNoLanguageReservedKeywords     = "PLUGIN_ONEOF_NO_LANGUAGE_RESERVED_KEYWORDS"
+	ruleIDExtensionNoLanguageReservedKeywords = "PLUGIN_EXTENSION_NO_LANGUAGE_RESERVED_KEYWORDS"
+	ruleIDFilePathNoLanguageReservedKeywords  = "PLUGIN_FILE_PATH_NO_LANGUAGE_RESERVED_KEYWORDS"
+	ruleIDGoPackageNoGoReservedKeywords       = "PLUGIN_GO_PACKAGE_NO_GO_RESERVED_KEYWORDS"
+	ruleIDNoWindowsReservedNames              = "PLUGIN_NO_WINDOWS_RESERVED_NAMES"
+	ruleIDNoLanguageReservedIdentifiers       = "PLUGIN_NO_LANGUAGE_RESERVED_IDENTIFIERS"
+	ruleIDNoCPreprocessorMacros               = "PLUGIN_NO_C_PREPROCESSOR_MACROS"
+	ruleIDGoGeneratedNameCollisions           = "PLUGIN_GO_GENERATED_NAME_COLLISIONS"
+	ruleIDJavaGeneratedNameCollisions         = "PLUGIN_JAVA_GENERATED_NAME_COLLISIONS"
+	ruleIDCppGeneratedNameCollisions          = "PLUGIN_CPP_GENERATED_NAME_COLLISIONS"
+	ruleIDCSharpGeneratedNameCollisions       = "PLUGIN_CSHARP_GENERATED_NAME_COLLISIONS"
+	ruleIDObjCGeneratedNameCollisions         = "PLUGIN_OBJC_GENERATED_NAME_COLLISIONS"
+	ruleIDDartGeneratedNameCollisions         = "PLUGIN_DART_GENERATED_NAME_COLLISIONS"
+	ruleIDFieldNoLanguageMessageMembers       = "PLUGIN_FIELD_NO_LANGUAGE_MESSAGE_MEMBERS"
+	ruleIDPHPReservedClassNames               = "PLUGIN_PHP_RESERVED_CLASS_NAMES"
+	ruleIDTypeScriptGeneratedNameCollisions   = "PLUGIN_TYPESCRIPT_GENERATED_NAME_COLLISIONS"
 
 	// enabledLanguagesOptionKey is the option key to override the default set of enabled
 	// languages.
 	// By default, all languages are checked.
 	enabledLanguagesOptionKey = "enabled_languages"
+	// unescapableKeywordsOnlyLanguagesOptionKey is the option key to only report names
+	// that generators can't use as-is for the given languages: keywords they can't escape,
+	// and names they rename. For Go, every keyword is still checked in package names, as
+	// those can't be escaped, but other elements aren't checked for keywords.
+	// By default, all keywords are checked.
+	unescapableKeywordsOnlyLanguagesOptionKey = "unescapable_keywords_only_languages"
 )
 
 var spec = &check.Spec{
@@ -39,17 +74,164 @@ var spec = &check.Spec{
 		{
 			ID:      ruleIDPackageNoLanguageReservedKeywords,
 			Default: true,
-			Purpose: "Checks that all package names have no components that are language-reserved keywords.",
+			Purpose: "Checks that all package names, including java_package, csharp_namespace, php_namespace and ruby_package when set, have no components that are language-reserved keywords, and do not end in a predeclared identifier.",
 			Type:    check.RuleTypeLint,
 			Handler: checkutil.NewFileRuleHandler(checkPackageNoLanguageReservedKeywords, checkutil.WithoutImports()),
 		},
 		{
 			ID:      ruleIDFieldNoLanguageReservedKeywords,
 			Default: true,
-			Purpose: "Checks that all field names are not language-reserved keywords.",
+			Purpose: "Checks that all field names, excluding extensions, are not language-reserved keywords.",
 			Type:    check.RuleTypeLint,
 			Handler: checkutil.NewFieldRuleHandler(checkFieldNoLanguageReservedKeywords, checkutil.WithoutImports()),
 		},
+		{
+			ID:      ruleIDMessageNoLanguageReservedKeywords,
+			Default: true,
+			Purpose: "Checks that all message names are not language-reserved keywords.",
+			Type:    check.RuleTypeLint,
+			Handler: checkutil.NewMessageRuleHandler(checkMessageNoLanguageReservedKeywords, checkutil.WithoutImports()),
+		},
+		{
+			ID:      ruleIDEnumNoLanguageReservedKeywords,
+			Default: true,
+			Purpose: "Checks that all enum names are not language-reserved keywords.",
+			Type:    check.RuleTypeLint,
+			Handler: checkutil.NewEnumRuleHandler(checkEnumNoLanguageReservedKeywords, checkutil.WithoutImports()),
+		},
+		{
+			ID:      ruleIDEnumValueNoLanguageReservedKeywords,
+			Default: true,
+			Purpose: "Checks that all enum value names, including their generated prefix-stripped forms, are not language-reserved keywords.",
+			Type:    check.RuleTypeLint,
+			Handler: checkutil.NewEnumValueRuleHandler(checkEnumValueNoLanguageReservedKeywords, checkutil.WithoutImports()),
+		},
+		{
+			ID:      ruleIDServiceNoLanguageReservedKeywords,
+			Default: true,
+			Purpose: "Checks that all service names are not language-reserved keywords.",
+			Type:    check.RuleTypeLint,
+			Handler: checkutil.NewServiceRuleHandler(checkServiceNoLanguageReservedKeywords, checkutil.WithoutImports()),
+		},
+		{
+			ID:      ruleIDMethodNoLanguageReservedKeywords,
+			Default: true,
+			Purpose: "Checks that all method names, including their generated lowerCamelCase forms, are not language-reserved keywords.",
+			Type:    check.RuleTypeLint,
+			Handler: checkutil.NewMethodRuleHandler(checkMethodNoLanguageReservedKeywords, checkutil.WithoutImports()),
+		},
+		{
+			ID:      ruleIDOneofNoLanguageReservedKeywords,
+			Default: true,
+			Purpose: "Checks that all oneof names are not language-reserved keywords.",
+			Type:    check.RuleTypeLint,
+			Handler: checkutil.NewOneofRuleHandler(checkOneofNoLanguageReservedKeywords, checkutil.WithoutImports()),
+		},
+		{
+			ID:      ruleIDExtensionNoLanguageReservedKeywords,
+			Default: true,
+			Purpose: "Checks that all extension names are not language-reserved keywords.",
+			Type:    check.RuleTypeLint,
+			Handler: checkutil.NewFieldRuleHandler(checkExtensionNoLanguageReservedKeywords, checkutil.WithoutImports()),
+		},
+		{
+			ID:      ruleIDFilePathNoLanguageReservedKeywords,
+			Default: true,
+			Purpose: "Checks that all file paths have no directory or file name components that are language-reserved keywords, for languages that generate identifiers from them.",
+			Type:    check.RuleTypeLint,
+			Handler: checkutil.NewFileRuleHandler(checkFilePathNoLanguageReservedKeywords, checkutil.WithoutImports()),
+		},
+		{
+			ID:      ruleIDGoPackageNoGoReservedKeywords,
+			Default: true,
+			Purpose: "Checks that all Go package names derived from go_package are valid Go identifiers that are not Go reserved keywords or predeclared identifiers.",
+			Type:    check.RuleTypeLint,
+			Handler: checkutil.NewFileRuleHandler(checkGoPackageNoGoReservedKeywords, checkutil.WithoutImports()),
+		},
+		{
+			ID:      ruleIDNoWindowsReservedNames,
+			Default: true,
+			Purpose: "Checks that all package names, java_package and go_package paths, and file paths have no components that are Windows reserved device names.",
+			Type:    check.RuleTypeLint,
+			Handler: checkutil.NewFileRuleHandler(checkNoWindowsReservedNames, checkutil.WithoutImports()),
+		},
+		{
+			ID:      ruleIDNoLanguageReservedIdentifiers,
+			Default: true,
+			Purpose: "Checks that all package name components, message names and field names do not match language-reserved identifier patterns.",
+			Type:    check.RuleTypeLint,
+			Handler: checkutil.NewFileRuleHandler(checkNoLanguageReservedIdentifiers, checkutil.WithoutImports()),
+		},
+		{
+			ID:      ruleIDNoCPreprocessorMacros,
+			Default: false,
+			Purpose: "Checks that all message, field, enum and enum value names do not collide with well-known C and C++ preprocessor macros.",
+			Type:    check.RuleTypeLint,
+			Handler: checkutil.NewFileRuleHandler(checkNoCPreprocessorMacros, checkutil.WithoutImports()),
+		},
+		{
+			ID:      ruleIDGoGeneratedNameCollisions,
+			Default: true,
+			Purpose: "Checks that all messages generate Go identifiers with protoc-gen-go that are not renamed or colliding.",
+			Type:    check.RuleTypeLint,
+			Handler: checkutil.NewMessageRuleHandler(checkGoGeneratedNameCollisions, checkutil.WithoutImports()),
+		},
+		{
+			ID:      ruleIDJavaGeneratedNameCollisions,
+			Default: true,
+			Purpose: "Checks that all messages generate Java accessors with protobuf-java that are not renamed or colliding.",
+			Type:    check.RuleTypeLint,
+			Handler: checkutil.NewMessageRuleHandler(checkJavaGeneratedNameCollisions, checkutil.WithoutImports()),
+		},
+		{
+			ID:      ruleIDCppGeneratedNameCollisions,
+			Default: true,
+			Purpose: "Checks that all messages generate C++ accessors with protoc that don't collide.",
+			Type:    check.RuleTypeLint,
+			Handler: checkutil.NewMessageRuleHandler(checkCppGeneratedNameCollisions, checkutil.WithoutImports()),
+		},
+		{
+			ID:      ruleIDCSharpGeneratedNameCollisions,
+			Default: true,
+			Purpose: "Checks that all messages generate C# members with protoc-gen-csharp that are not renamed or colliding with their enclosing type.",
+			Type:    check.RuleTypeLint,
+			Handler: checkutil.NewMessageRuleHandler(checkCSharpGeneratedNameCollisions, checkutil.WithoutImports()),
+		},
+		{
+			ID:      ruleIDObjCGeneratedNameCollisions,
+			Default: true,
+			Purpose: "Checks that all fields generate Objective-C properties with protobuf-objc that are not renamed or in ARC method families.",
+			Type:    check.RuleTypeLint,
+			Handler: checkutil.NewFieldRuleHandler(checkObjCGeneratedNameCollisions, checkutil.WithoutImports()),
+		},
+		{
+			ID:      ruleIDDartGeneratedNameCollisions,
+			Default: true,
+			Purpose: "Checks that all fields generate Dart fields with protoc-gen-dart that are not renamed.",
+			Type:    check.RuleTypeLint,
+			Handler: checkutil.NewFieldRuleHandler(checkDartGeneratedNameCollisions, checkutil.WithoutImports()),
+		},
+		{
+			ID:      ruleIDFieldNoLanguageMessageMembers,
+			Default: false,
+			Purpose: "Checks that all field names, excluding extensions, don't shadow members of messages in dynamic language runtimes.",
+			Type:    check.RuleTypeLint,
+			Handler: checkutil.NewFieldRuleHandler(checkFieldNoLanguageMessageMembers, checkutil.WithoutImports()),
+		},
+		{
+			ID:      ruleIDPHPReservedClassNames,
+			Default: true,
+			Purpose: "Checks that all message, enum and package names are not PHP reserved class names, which protoc-gen-php prefixes.",
+			Type:    check.RuleTypeLint,
+			Handler: checkutil.NewFileRuleHandler(checkPHPReservedClassNames, checkutil.WithoutImports()),
+		},
+		{
+			ID:      ruleIDTypeScriptGeneratedNameCollisions,
+			Default: true,
+			Purpose: "Checks that all fields and oneofs generate TypeScript and JavaScript properties with protobuf-es that are not renamed, and that message and enum names don't collide with declarations generated by ts-proto.",
+			Type:    check.RuleTypeLint,
+			Handler: checkutil.NewFileRuleHandler(checkTypeScriptGeneratedNameCollisions, checkutil.WithoutImports()),
+		},
 	},
 	Info: &info.Spec{
 		Documentation: readmeMarkdown,
@@ -64,21 +246,71 @@ func checkPackageNoLanguageReservedKeywords(
 	request check.Request,
 	fileDescriptor descriptor.FileDescriptor,
 ) error {
-	validLanguages, err := getOptions(request)
+	options, err := getOptions(request)
 	if err != nil {
 		return fmt.Errorf("parsing options: %w", err)
 	}
+	fileOptions := fileDescriptor.FileDescriptorProto().GetOptions()
+	// Languages whose package is replaced by a package option that's set are checked
+	// against that option instead of the package.
+	var packageOptionLanguages []string
+	for _, packageOption := range packageOptions {
+		optionValue := packageOption.getValue(fileOptions)
+		if optionValue == "" {
+			continue
+		}
+		packageOptionLanguages = append(packageOptionLanguages, packageOption.languages...)
+		for optionComponent := range strings.SplitSeq(optionValue, packageOption.separator) {
+			for _, language := range packageOption.languages {
+				if !slices.Contains(options.validLanguages, strings.ToLower(language)) {
+					// Skip languages that aren't enabled.
+					continue
+				}
+				matchesKeyword := func(reservedKeyword string) bool {
+					if packageOption.caseInsensitive {
+						return strings.EqualFold(reservedKeyword, optionComponent)
+					}
+					return reservedKeyword == optionComponent
+				}
+				if slices.ContainsFunc(options.languageReservedKeywords[language], matchesKeyword) {
+					responseWriter.AddAnnotation(
+						check.WithMessagef(
+							"Package name %q from %s should not use %s reserved keyword %q.",
+							optionValue,
+							packageOption.name,
+							language,
+							optionComponent,
+						),
+						check.WithFileNameAndSourcePath(
+							*fileDescriptor.FileDescriptorProto().Name,
+							// https://github.com/protocolbuffers/protobuf/blob/6556a4ea26f2273797f559ebad87df42cd540443/src/google/protobuf/descriptor.proto#L124
+							[]int32{8, packageOption.fieldNumber},
+						),
+					)
+				}
+			}
+		}
+	}
+	if fileOptions.GetGoPackage() != "" {
+		// The Go package name comes from go_package instead, which is checked by
+		// checkGoPackageNoGoReservedKeywords.
+		packageOptionLanguages = append(packageOptionLanguages, "Go")
+	}
 	packageName := fileDescriptor.FileDescriptorProto().Package
 	if packageName == nil {
 		return nil
 	}
 	packageComponents := strings.SplitSeq(*packageName, ".")
 	for packageComponent := range packageComponents {
-		for language, reservedKeywords := range languageReservedKeywords {
-			if !slices.Contains(validLanguages, strings.ToLower(language)) {
+		for language, reservedKeywords := range options.languageReservedKeywords {
+			if !slices.Contains(options.validLanguages, strings.ToLower(language)) {
 				// Skip languages that aren't enabled.
 				continue
 			}
+			if slices.Contains(packageOptionLanguages, language) {
+				// Checked against the package option instead.
+				continue
+			}
 			if slices.Contains(reservedKeywords, packageComponent) {
 				responseWriter.AddAnnotation(
 					check.WithMessagef(
@@ -98,63 +330,2542 @@ func checkPackageNoLanguageReservedKeywords(
 			}
 		}
 	}
+	// The last package component is conventionally used as the package name, where
+	// predeclared identifiers are shadowed. When go_package is set, the Go package name
+	// comes from there instead and is checked by checkGoPackageNoGoReservedKeywords.
+	lastPackageComponent := (*packageName)[strings.LastIndexByte(*packageName, '.')+1:]
+	for language, predeclaredIdentifiers := range languagePredeclaredIdentifiers {
+		if !slices.Contains(options.validLanguages, strings.ToLower(language)) {
+			// Skip languages that aren't enabled.
+			continue
+		}
+		if language == "Go" && fileOptions.GetGoPackage() != "" {
+			continue
+		}
+		if slices.Contains(predeclaredIdentifiers, lastPackageComponent) {
+			responseWriter.AddAnnotation(
+				check.WithMessagef(
+					"Package name %q should not use %s predeclared identifier %q.",
+					*packageName,
+					language,
+					lastPackageComponent,
+				),
+				check.WithFileNameAndSourcePath(
+					*fileDescriptor.FileDescriptorProto().Name,
+					[]int32{2},
+				),
+			)
+		}
+	}
 	return nil
 }
 
-func checkFieldNoLanguageReservedKeywords(
+func checkFilePathNoLanguageReservedKeywords(
 	_ context.Context,
 	responseWriter check.ResponseWriter,
 	request check.Request,
-	fieldDescriptor protoreflect.FieldDescriptor,
+	fileDescriptor descriptor.FileDescriptor,
 ) error {
-	validLanguages, err := getOptions(request)
+	options, err := getOptions(request)
 	if err != nil {
 		return fmt.Errorf("parsing options: %w", err)
 	}
-	for language, reservedKeywords := range languageReservedKeywords {
-		if !slices.Contains(validLanguages, strings.ToLower(language)) {
-			// Skip languages that aren't enabled.
-			continue
+	filePath := fileDescriptor.FileDescriptorProto().GetName()
+	// File paths in descriptors are always '/'-separated, regardless of platform.
+	directory, fileName := path.Split(filePath)
+	checkPathComponent := func(component string, componentType string) {
+		for language, componentTypes := range languageFilePathComponentTypes {
+			if !slices.Contains(options.validLanguages, strings.ToLower(language)) {
+				// Skip languages that aren't enabled.
+				continue
+			}
+			if !slices.Contains(componentTypes, componentType) {
+				// The language doesn't generate identifiers from this component.
+				continue
+			}
+			if slices.Contains(options.languageReservedKeywords[language], component) {
+				responseWriter.AddAnnotation(
+					check.WithMessagef(
+						"File path %q should not use %s reserved keyword %q as a %s name.",
+						filePath,
+						language,
+						component,
+						componentType,
+					),
+					check.WithFileName(filePath),
+				)
+			}
 		}
-		fieldName := string(fieldDescriptor.Name())
-		if slices.Contains(reservedKeywords, fieldName) {
+	}
+	if directory != "" {
+		for directoryComponent := range strings.SplitSeq(strings.TrimSuffix(directory, "/"), "/") {
+			checkPathComponent(directoryComponent, "directory")
+		}
+	}
+	checkPathComponent(strings.TrimSuffix(fileName, ".proto"), "file")
+	return nil
+}
+
+func checkGoPackageNoGoReservedKeywords(
+	_ context.Context,
+	responseWriter check.ResponseWriter,
+	request check.Request,
+	fileDescriptor descriptor.FileDescriptor,
+) error {
+	options, err := getOptions(request)
+	if err != nil {
+		return fmt.Errorf("parsing options: %w", err)
+	}
+	if !slices.Contains(options.validLanguages, "go") {
+		return nil
+	}
+	goPackage := fileDescriptor.FileDescriptorProto().GetOptions().GetGoPackage()
+	if goPackage == "" {
+		return nil
+	}
+	goPackageName := goPackageNameForGoPackage(goPackage)
+	var message string
+	switch {
+	case !token.IsIdentifier(goPackageName) && !token.IsKeyword(goPackageName):
+		message = fmt.Sprintf(
+			"Go package name %q derived from go_package %q is not a valid Go identifier; protoc-gen-go will rename it to %q.",
+			goPackageName,
+			goPackage,
+			goSanitized(goPackageName),
+		)
+	case slices.Contains(options.languageReservedKeywords["Go"], goPackageName):
+		message = fmt.Sprintf(
+			"Go package name %q derived from go_package %q is a Go reserved keyword; protoc-gen-go will rename it to %q.",
+			goPackageName,
+			goPackage,
+			goSanitized(goPackageName),
+		)
+	case slices.Contains(languagePredeclaredIdentifiers["Go"], goPackageName):
+		message = fmt.Sprintf(
+			"Go package name %q derived from go_package %q should not shadow Go predeclared identifier %q.",
+			goPackageName,
+			goPackage,
+			goPackageName,
+		)
+	default:
+		return nil
+	}
+	responseWriter.AddAnnotation(
+		check.WithMessage(message),
+		check.WithFileNameAndSourcePath(
+			fileDescriptor.FileDescriptorProto().GetName(),
+			// https://github.com/protocolbuffers/protobuf/blob/6556a4ea26f2273797f559ebad87df42cd540443/src/google/protobuf/descriptor.proto#L124
+			// https://github.com/protocolbuffers/protobuf/blob/6556a4ea26f2273797f559ebad87df42cd540443/src/google/protobuf/descriptor.proto#L512
+			[]int32{8, 11},
+		),
+	)
+	return nil
+}
+
+func checkNoWindowsReservedNames(
+	_ context.Context,
+	responseWriter check.ResponseWriter,
+	request check.Request,
+	fileDescriptor descriptor.FileDescriptor,
+) error {
+	options, err := getOptions(request)
+	if err != nil {
+		return fmt.Errorf("parsing options: %w", err)
+	}
+	fileDescriptorProto := fileDescriptor.FileDescriptorProto()
+	filePath := fileDescriptorProto.GetName()
+	checkComponents := func(
+		value string,
+		separator string,
+		valueDescription string,
+		annotationOption check.AddAnnotationOption,
+	) {
+		for component := range strings.SplitSeq(value, separator) {
+			// Windows ignores everything after the first '.', so "aux.proto" is as reserved
+			// as "aux".
+			baseName, _, _ := strings.Cut(component, ".")
+			if slices.ContainsFunc(windowsReservedNames, func(windowsReservedName string) bool {
+				return strings.EqualFold(windowsReservedName, baseName)
+			}) {
+				responseWriter.AddAnnotation(
+					check.WithMessagef(
+						"%s should not use Windows reserved device name %q.",
+						valueDescription,
+						component,
+					),
+					annotationOption,
+				)
+			}
+		}
+	}
+	checkComponents(
+		filePath,
+		"/",
+		fmt.Sprintf("File path %q", filePath),
+		check.WithFileName(filePath),
+	)
+	if packageName := fileDescriptorProto.GetPackage(); packageName != "" {
+		checkComponents(
+			packageName,
+			".",
+			fmt.Sprintf("Package name %q", packageName),
+			check.WithFileNameAndSourcePath(filePath, []int32{2}),
+		)
+	}
+	fileOptions := fileDescriptorProto.GetOptions()
+	if javaPackage := fileOptions.GetJavaPackage(); javaPackage != "" &&
+		(slices.Contains(options.validLanguages, "java") || slices.Contains(options.validLanguages, "kotlin")) {
+		checkComponents(
+			javaPackage,
+			".",
+			fmt.Sprintf("Package name %q from java_package", javaPackage),
+			check.WithFileNameAndSourcePath(filePath, []int32{8, 1}),
+		)
+	}
+	if goPackage := fileOptions.GetGoPackage(); goPackage != "" && slices.Contains(options.validLanguages, "go") {
+		goImportPath, _, _ := strings.Cut(goPackage, ";")
+		checkComponents(
+			goImportPath,
+			"/",
+			fmt.Sprintf("Import path %q from go_package", goImportPath),
+			check.WithFileNameAndSourcePath(filePath, []int32{8, 11}),
+		)
+	}
+	return nil
+}
+
+func checkNoLanguageReservedIdentifiers(
+	_ context.Context,
+	responseWriter check.ResponseWriter,
+	request check.Request,
+	fileDescriptor descriptor.FileDescriptor,
+) error {
+	options, err := getOptions(request)
+	if err != nil {
+		return fmt.Errorf("parsing options: %w", err)
+	}
+	checkName := func(
+		elementType string,
+		name string,
+		identifier string,
+		annotationOption check.AddAnnotationOption,
+	) {
+		for language, reservedIdentifierPatterns := range languageReservedIdentifierPatterns {
+			if !slices.Contains(options.validLanguages, strings.ToLower(language)) {
+				// Skip languages that aren't enabled.
+				continue
+			}
+			for _, reservedIdentifierPattern := range reservedIdentifierPatterns {
+				if reservedIdentifierPattern.pattern.MatchString(identifier) {
+					responseWriter.AddAnnotation(
+						check.WithMessagef(
+							"%s name %q should not use %s reserved identifier %q, as identifiers %s are reserved.",
+							elementType,
+							name,
+							language,
+							identifier,
+							reservedIdentifierPattern.description,
+						),
+						annotationOption,
+					)
+				}
+			}
+		}
+	}
+	fileDescriptorProto := fileDescriptor.FileDescriptorProto()
+	if packageName := fileDescriptorProto.GetPackage(); packageName != "" {
+		for packageComponent := range strings.SplitSeq(packageName, ".") {
+			checkName(
+				"Package",
+				packageName,
+				packageComponent,
+				check.WithFileNameAndSourcePath(fileDescriptorProto.GetName(), []int32{2}),
+			)
+		}
+	}
+	forEachMessage(
+		fileDescriptor.ProtoreflectFileDescriptor().Messages(),
+		func(messageDescriptor protoreflect.MessageDescriptor) {
+			if isMapEntry(messageDescriptor) {
+				return
+			}
+			messageName := string(messageDescriptor.Name())
+			checkName("Message", messageName, messageName, check.WithDescriptor(messageDescriptor))
+			fields := messageDescriptor.Fields()
+			for i := range fields.Len() {
+				fieldDescriptor := fields.Get(i)
+				fieldName := string(fieldDescriptor.Name())
+				checkName("Field", fieldName, fieldName, check.WithDescriptor(fieldDescriptor))
+			}
+		},
+	)
+	return nil
+}
+
+func checkNoCPreprocessorMacros(
+	_ context.Context,
+	responseWriter check.ResponseWriter,
+	request check.Request,
+	fileDescriptor descriptor.FileDescriptor,
+) error {
+	options, err := getOptions(request)
+	if err != nil {
+		return fmt.Errorf("parsing options: %w", err)
+	}
+	checkName := func(elementType string, descriptor protoreflect.Descriptor) {
+		name := string(descriptor.Name())
+		macroIndex := slices.IndexFunc(cPreprocessorMacros, func(macro cPreprocessorMacro) bool {
+			return macro.name == name
+		})
+		if macroIndex < 0 {
+			return
+		}
+		macro := cPreprocessorMacros[macroIndex]
+		for _, language := range cPreprocessorLanguages {
+			if !slices.Contains(options.validLanguages, strings.ToLower(language)) {
+				// Skip languages that aren't enabled.
+				continue
+			}
 			responseWriter.AddAnnotation(
 				check.WithMessagef(
-					"Field name %q should not use %s reserved keyword %q.",
-					fieldName,
+					"%s name %q should not collide with %s preprocessor macro %q defined by %s.",
+					elementType,
+					name,
 					language,
-					fieldName,
+					name,
+					strings.Join(macro.platforms, ", "),
 				),
-				check.WithDescriptor(fieldDescriptor),
+				check.WithDescriptor(descriptor),
 			)
 		}
 	}
+	checkEnums := func(enums protoreflect.EnumDescriptors) {
+		for i := range enums.Len() {
+			enumDescriptor := enums.Get(i)
+			checkName("Enum", enumDescriptor)
+			enumValues := enumDescriptor.Values()
+			for j := range enumValues.Len() {
+				checkName("Enum value", enumValues.Get(j))
+			}
+		}
+	}
+	protoreflectFileDescriptor := fileDescriptor.ProtoreflectFileDescriptor()
+	checkEnums(protoreflectFileDescriptor.Enums())
+	forEachMessage(
+		protoreflectFileDescriptor.Messages(),
+		func(messageDescriptor protoreflect.MessageDescriptor) {
+			if isMapEntry(messageDescriptor) {
+				return
+			}
+			checkName("Message", messageDescriptor)
+			fields := messageDescriptor.Fields()
+			for i := range fields.Len() {
+				checkName("Field", fields.Get(i))
+			}
+			checkEnums(messageDescriptor.Enums())
+		},
+	)
 	return nil
 }
 
-func getOptions(request check.Request) (validLanguages []string, err error) {
-	// Default to all languages being enabled.
-	validLanguages = make([]string, 0, len(languageReservedKeywords))
-	for language := range languageReservedKeywords {
-		validLanguages = append(validLanguages, strings.ToLower(language))
-	}
-	enabledLanguagesOptionKey, err := option.GetStringSliceValue(request.Options(), enabledLanguagesOptionKey)
+func checkGoGeneratedNameCollisions(
+	_ context.Context,
+	responseWriter check.ResponseWriter,
+	request check.Request,
+	messageDescriptor protoreflect.MessageDescriptor,
+) error {
+	options, err := getOptions(request)
 	if err != nil {
-		return nil, err
+		return fmt.Errorf("parsing options: %w", err)
 	}
-	if len(enabledLanguagesOptionKey) != 0 {
-		for _, optionLanguage := range enabledLanguagesOptionKey {
-			if !slices.Contains(validLanguages, optionLanguage) {
-				return nil, fmt.Errorf("invalid language given %q, expected one of: %q", optionLanguage, strings.Join(validLanguages, ", "))
+	if !slices.Contains(options.validLanguages, "go") || isMapEntry(messageDescriptor) {
+		return nil
+	}
+	messageGoName := goIdentName(messageDescriptor)
+	// This mirrors protoc-gen-go's resolution of conflicts between generated names.
+	// https://github.com/protocolbuffers/protobuf-go/blob/v1.36.11/compiler/protogen/protogen.go#L755
+	usedNames := map[string]bool{
+		"Reset":               true,
+		"String":              true,
+		"ProtoMessage":        true,
+		"Marshal":             true,
+		"Unmarshal":           true,
+		"ExtensionRangeArray": true,
+		"ExtensionMap":        true,
+		"Descriptor":          true,
+	}
+	makeNameUnique := func(name string, hasGetter bool) (string, string) {
+		var collidingName string
+		for usedNames[name] || (hasGetter && usedNames["Get"+name]) {
+			if collidingName == "" {
+				collidingName = name
+				if !usedNames[name] {
+					collidingName = "Get" + name
+				}
 			}
+			name += "_"
 		}
-		// Use the specified languages instead.
-		validLanguages = enabledLanguagesOptionKey
+		usedNames[name] = true
+		usedNames["Get"+name] = hasGetter
+		return name, collidingName
 	}
-	return validLanguages, nil
-}
-
-var (
+	nestedTypeGoNames := make(map[string]bool)
+	for i := range messageDescriptor.Messages().Len() {
+		nestedTypeGoNames[goIdentName(messageDescriptor.Messages().Get(i))] = true
+	}
+	for i := range messageDescriptor.Enums().Len() {
+		nestedTypeGoNames[goIdentName(messageDescriptor.Enums().Get(i))] = true
+	}
+	fields := messageDescriptor.Fields()
+	for i := range fields.Len() {
+		fieldDescriptor := fields.Get(i)
+		fieldName := string(fieldDescriptor.Name())
+		fieldGoName, collidingName := makeNameUnique(goCamelCase(fieldName), true)
+		switch {
+		case collidingName != "":
+			responseWriter.AddAnnotation(
+				check.WithMessagef(
+					"Field name %q is generated as %q by protoc-gen-go to avoid colliding with %q.",
+					fieldName,
+					fieldGoName,
+					collidingName,
+				),
+				check.WithDescriptor(fieldDescriptor),
+			)
+		case fieldGoName == "ProtoReflect":
+			// protoc-gen-go doesn't account for this method, so the generated code won't
+			// compile.
+			responseWriter.AddAnnotation(
+				check.WithMessagef(
+					"Field name %q is generated as %q by protoc-gen-go, which collides with the generated %q method.",
+					fieldName,
+					fieldGoName,
+					"ProtoReflect",
+				),
+				check.WithDescriptor(fieldDescriptor),
+			)
+		case strings.HasPrefix(fieldGoName, "XXX_"):
+			responseWriter.AddAnnotation(
+				check.WithMessagef(
+					"Field name %q is generated as %q by protoc-gen-go, which uses the %q prefix reserved for generated code.",
+					fieldName,
+					fieldGoName,
+					"XXX_",
+				),
+				check.WithDescriptor(fieldDescriptor),
+			)
+		}
+		oneofDescriptor := fieldDescriptor.ContainingOneof()
+		if oneofDescriptor == nil || oneofDescriptor.IsSynthetic() {
+			continue
+		}
+		if oneofDescriptor.Fields().Get(0) == fieldDescriptor {
+			// protoc-gen-go assumes oneofs don't have getters, although they do.
+			oneofName := string(oneofDescriptor.Name())
+			oneofGoName, collidingName := makeNameUnique(goCamelCase(oneofName), false)
+			if collidingName != "" {
+				responseWriter.AddAnnotation(
+					check.WithMessagef(
+						"Oneof name %q is generated as %q by protoc-gen-go to avoid colliding with %q.",
+						oneofName,
+						oneofGoName,
+						collidingName,
+					),
+					check.WithDescriptor(oneofDescriptor),
+				)
+			}
+		}
+		// Each oneof field generates a wrapper type, which protoc-gen-go renames if it
+		// collides with a nested type.
+		fieldIdentGoName := messageGoName + "_" + fieldGoName
+		wrapperGoName := fieldIdentGoName
+		for nestedTypeGoNames[wrapperGoName] {
+			wrapperGoName += "_"
+		}
+		if wrapperGoName != fieldIdentGoName {
+			responseWriter.AddAnnotation(
+				check.WithMessagef(
+					"Oneof field name %q generates a wrapper type named %q by protoc-gen-go to avoid colliding with nested type %q.",
+					fieldName,
+					wrapperGoName,
+					fieldIdentGoName,
+				),
+				check.WithDescriptor(fieldDescriptor),
+			)
+		}
+	}
+	return nil
+}
+
+func checkJavaGeneratedNameCollisions(
+	_ context.Context,
+	responseWriter check.ResponseWriter,
+	request check.Request,
+	messageDescriptor protoreflect.MessageDescriptor,
+) error {
+	options, err := getOptions(request)
+	if err != nil {
+		return fmt.Errorf("parsing options: %w", err)
+	}
+	if !slices.Contains(options.validLanguages, "java") || isMapEntry(messageDescriptor) {
+		return nil
+	}
+	fields := messageDescriptor.Fields()
+	capitalizedNames := make([]string, fields.Len())
+	for i := range fields.Len() {
+		capitalizedNames[i] = javaCamelCase(string(fields.Get(i).Name()), true)
+	}
+	// This mirrors protobuf-java's resolution of conflicts between fields, which appends
+	// the field number to the names of both fields.
+	// https://github.com/protocolbuffers/protobuf/blob/v29.0/src/google/protobuf/compiler/java/context.cc
+	conflictingFieldNames := make([]string, fields.Len())
+	for i := range fields.Len() {
+		for j := i + 1; j < fields.Len(); j++ {
+			if !javaIsConflicting(fields.Get(i), capitalizedNames[i], fields.Get(j), capitalizedNames[j]) {
+				continue
+			}
+			if conflictingFieldNames[i] == "" {
+				conflictingFieldNames[i] = string(fields.Get(j).Name())
+			}
+			if conflictingFieldNames[j] == "" {
+				conflictingFieldNames[j] = string(fields.Get(i).Name())
+			}
+		}
+	}
+	for i := range fields.Len() {
+		if conflictingFieldNames[i] == "" {
+			continue
+		}
+		fieldDescriptor := fields.Get(i)
+		capitalizedNames[i] += strconv.Itoa(int(fieldDescriptor.Number()))
+		responseWriter.AddAnnotation(
+			check.WithMessagef(
+				"Field name %q is generated as %q in Java accessors by protobuf-java to avoid colliding with field %q.",
+				string(fieldDescriptor.Name()),
+				capitalizedNames[i],
+				conflictingFieldNames[i],
+			),
+			check.WithDescriptor(fieldDescriptor),
+		)
+	}
+	// accessorFieldNames maps each accessor to the name of the field that generated it.
+	accessorFieldNames := make(map[string]string)
+	for i := range fields.Len() {
+		fieldDescriptor := fields.Get(i)
+		fieldName := string(fieldDescriptor.Name())
+		capitalizedName := capitalizedNames[i]
+		if slices.ContainsFunc(javaForbiddenFieldNames, func(forbiddenFieldName string) bool {
+			return strings.EqualFold(forbiddenFieldName, capitalizedName)
+		}) {
+			// protobuf-java renames these, so they won't collide with anything.
+			responseWriter.AddAnnotation(
+				check.WithMessagef(
+					"Field name %q is generated as Java accessor %q by protobuf-java to avoid colliding with the inherited %q method.",
+					fieldName,
+					"get"+capitalizedName+"_",
+					"get"+capitalizedName,
+				),
+				check.WithDescriptor(fieldDescriptor),
+			)
+			continue
+		}
+		for _, accessor := range javaAccessors(fieldDescriptor, capitalizedName) {
+			if slices.Contains(javaInheritedMethods, accessor) {
+				responseWriter.AddAnnotation(
+					check.WithMessagef(
+						"Field name %q generates Java accessor %q, which collides with the inherited %q method.",
+						fieldName,
+						accessor,
+						accessor,
+					),
+					check.WithDescriptor(fieldDescriptor),
+				)
+				break
+			}
+			if collidingFieldName, ok := accessorFieldNames[accessor]; ok {
+				// protobuf-java doesn't resolve this conflict, so the generated code won't
+				// compile.
+				responseWriter.AddAnnotation(
+					check.WithMessagef(
+						"Field name %q generates Java accessor %q, which collides with the accessor generated for field %q.",
+						fieldName,
+						accessor,
+						collidingFieldName,
+					),
+					check.WithDescriptor(fieldDescriptor),
+				)
+				break
+			}
+		}
+		for _, accessor := range javaAccessors(fieldDescriptor, capitalizedName) {
+			if _, ok := accessorFieldNames[accessor]; !ok {
+				accessorFieldNames[accessor] = fieldName
+			}
+		}
+	}
+	return nil
+}
+
+func checkCppGeneratedNameCollisions(
+	_ context.Context,
+	responseWriter check.ResponseWriter,
+	request check.Request,
+	messageDescriptor protoreflect.MessageDescriptor,
+) error {
+	options, err := getOptions(request)
+	if err != nil {
+		return fmt.Errorf("parsing options: %w", err)
+	}
+	if !slices.Contains(options.validLanguages, "c++") || isMapEntry(messageDescriptor) {
+		return nil
+	}
+	// memberElementNames maps each generated member to a description of the element that
+	// generated it.
+	memberElementNames := make(map[string]string)
+	checkMembers := func(elementType string, name string, members []string, descriptor protoreflect.Descriptor) {
+		for _, member := range members {
+			if slices.Contains(cppMessageMembers, member) {
+				responseWriter.AddAnnotation(
+					check.WithMessagef(
+						"%s name %q generates C++ member %q, which collides with the inherited %q member.",
+						elementType,
+						name,
+						member,
+						member,
+					),
+					check.WithDescriptor(descriptor),
+				)
+				break
+			}
+			if collidingElementName, ok := memberElementNames[member]; ok {
+				responseWriter.AddAnnotation(
+					check.WithMessagef(
+						"%s name %q generates C++ member %q, which collides with the member generated for %s.",
+						elementType,
+						name,
+						member,
+						collidingElementName,
+					),
+					check.WithDescriptor(descriptor),
+				)
+				break
+			}
+		}
+		for _, member := range members {
+			if _, ok := memberElementNames[member]; !ok {
+				memberElementNames[member] = fmt.Sprintf("%s %q", strings.ToLower(elementType), name)
+			}
+		}
+	}
+	fields := messageDescriptor.Fields()
+	for i := range fields.Len() {
+		fieldDescriptor := fields.Get(i)
+		checkMembers("Field", string(fieldDescriptor.Name()), cppFieldMembers(fieldDescriptor), fieldDescriptor)
+		oneofDescriptor := fieldDescriptor.ContainingOneof()
+		if oneofDescriptor == nil || oneofDescriptor.IsSynthetic() || oneofDescriptor.Fields().Get(0) != fieldDescriptor {
+			continue
+		}
+		oneofName := cppFieldName(string(oneofDescriptor.Name()))
+		checkMembers(
+			"Oneof",
+			string(oneofDescriptor.Name()),
+			[]string{
+				"clear_" + oneofName,
+				oneofName + "_case",
+			},
+			oneofDescriptor,
+		)
+	}
+	return nil
+}
+
+func checkCSharpGeneratedNameCollisions(
+	_ context.Context,
+	responseWriter check.ResponseWriter,
+	request check.Request,
+	messageDescriptor protoreflect.MessageDescriptor,
+) error {
+	options, err := getOptions(request)
+	if err != nil {
+		return fmt.Errorf("parsing options: %w", err)
+	}
+	if !slices.Contains(options.validLanguages, "c#") || isMapEntry(messageDescriptor) {
+		return nil
+	}
+	className := string(messageDescriptor.Name())
+	if parentMessageDescriptor, ok := messageDescriptor.Parent().(protoreflect.MessageDescriptor); ok && className == "Types" {
+		// Nested types are generated within a static Types class.
+		responseWriter.AddAnnotation(
+			check.WithMessagef(
+				"Message name %q generates C# class %q by protoc-gen-csharp, which collides with its enclosing %q class.",
+				className,
+				string(parentMessageDescriptor.Name())+".Types."+className,
+				"Types",
+			),
+			check.WithDescriptor(messageDescriptor),
+		)
+	}
+	// isCollidingName reports whether a member can't be named name within the class.
+	isCollidingName := func(name string) bool {
+		return name == className || slices.Contains(csharpMessageMembers, name)
+	}
+	fields := messageDescriptor.Fields()
+	for i := range fields.Len() {
+		fieldDescriptor := fields.Get(i)
+		fieldName := string(fieldDescriptor.Name())
+		if fieldDescriptor.Kind() == protoreflect.GroupKind {
+			// Groups are named after their message.
+			fieldName = string(fieldDescriptor.Message().Name())
+		}
+		if propertyName := csharpPascalCase(fieldName); isCollidingName(propertyName) {
+			responseWriter.AddAnnotation(
+				check.WithMessagef(
+					"Field name %q is generated as C# property %q by protoc-gen-csharp to avoid colliding with %q.",
+					string(fieldDescriptor.Name()),
+					propertyName+"_",
+					propertyName,
+				),
+				check.WithDescriptor(fieldDescriptor),
+			)
+		}
+	}
+	oneofs := messageDescriptor.Oneofs()
+	for i := range oneofs.Len() {
+		oneofDescriptor := oneofs.Get(i)
+		if oneofDescriptor.IsSynthetic() {
+			continue
+		}
+		oneofName := string(oneofDescriptor.Name())
+		// protoc-gen-csharp doesn't rename oneof members, so the generated code won't
+		// compile.
+		for _, memberName := range []string{
+			csharpPascalCase(oneofName) + "Case",
+			csharpPascalCase(oneofName) + "OneofCase",
+		} {
+			if isCollidingName(memberName) {
+				responseWriter.AddAnnotation(
+					check.WithMessagef(
+						"Oneof name %q generates C# member %q by protoc-gen-csharp, which collides with %q.",
+						oneofName,
+						memberName,
+						memberName,
+					),
+					check.WithDescriptor(oneofDescriptor),
+				)
+				break
+			}
+		}
+	}
+	return nil
+}
+
+func checkObjCGeneratedNameCollisions(
+	_ context.Context,
+	responseWriter check.ResponseWriter,
+	request check.Request,
+	fieldDescriptor protoreflect.FieldDescriptor,
+) error {
+	options, err := getOptions(request)
+	if err != nil {
+		return fmt.Errorf("parsing options: %w", err)
+	}
+	if !slices.Contains(options.validLanguages, "objective-c") || fieldDescriptor.IsExtension() {
+		return nil
+	}
+	fieldName := string(fieldDescriptor.Name())
+	if fieldDescriptor.Kind() == protoreflect.GroupKind {
+		// Groups are named after their message.
+		fieldName = string(fieldDescriptor.Message().Name())
+	}
+	// This mirrors protobuf-objc's naming of properties.
+	// https://github.com/protocolbuffers/protobuf/blob/v29.0/src/google/protobuf/compiler/objectivec/names.cc#L1152
+	propertyName := objcCamelCase(fieldName)
+	if fieldDescriptor.IsList() {
+		propertyName += "Array"
+	}
+	if slices.Contains(objcNSObjectMembers, propertyName) {
+		responseWriter.AddAnnotation(
+			check.WithMessagef(
+				"Field name %q is generated as Objective-C property %q by protobuf-objc to avoid colliding with the NSObject %q member.",
+				string(fieldDescriptor.Name()),
+				propertyName+"_p",
+				propertyName,
+			),
+			check.WithDescriptor(fieldDescriptor),
+		)
+		return nil
+	}
+	// ARC's method families only apply to methods returning objects.
+	isObject := fieldDescriptor.IsList() || fieldDescriptor.IsMap()
+	switch fieldDescriptor.Kind() {
+	case protoreflect.StringKind, protoreflect.BytesKind, protoreflect.MessageKind, protoreflect.GroupKind:
+		isObject = true
+	}
+	if !isObject {
+		return nil
+	}
+	if methodFamily, ok := objcMethodFamily(propertyName); ok {
+		// protobuf-objc keeps the name, but annotates the getter so ARC doesn't treat it as
+		// returning a retained object.
+		responseWriter.AddAnnotation(
+			check.WithMessagef(
+				"Field name %q generates Objective-C property %q, whose getter is in the ARC %q method family and needs protobuf-objc to override its ownership semantics.",
+				string(fieldDescriptor.Name()),
+				propertyName,
+				methodFamily,
+			),
+			check.WithDescriptor(fieldDescriptor),
+		)
+	}
+	return nil
+}
+
+func checkDartGeneratedNameCollisions(
+	_ context.Context,
+	responseWriter check.ResponseWriter,
+	request check.Request,
+	fieldDescriptor protoreflect.FieldDescriptor,
+) error {
+	options, err := getOptions(request)
+	if err != nil {
+		return fmt.Errorf("parsing options: %w", err)
+	}
+	if !slices.Contains(options.validLanguages, "dart") || fieldDescriptor.IsExtension() {
+		return nil
+	}
+	fieldName := string(fieldDescriptor.Name())
+	dartFieldName := dartCamelCase(fieldName)
+	if !slices.Contains(dartGeneratedMessageMembers, dartFieldName) {
+		return nil
+	}
+	responseWriter.AddAnnotation(
+		check.WithMessagef(
+			"Field name %q is generated as Dart field %q by protoc-gen-dart to avoid colliding with the inherited %q member.",
+			fieldName,
+			fmt.Sprintf("%s_%d", dartFieldName, fieldDescriptor.Number()),
+			dartFieldName,
+		),
+		check.WithDescriptor(fieldDescriptor),
+	)
+	return nil
+}
+
+func checkFieldNoLanguageMessageMembers(
+	_ context.Context,
+	responseWriter check.ResponseWriter,
+	request check.Request,
+	fieldDescriptor protoreflect.FieldDescriptor,
+) error {
+	if fieldDescriptor.IsExtension() {
+		return nil
+	}
+	options, err := getOptions(request)
+	if err != nil {
+		return fmt.Errorf("parsing options: %w", err)
+	}
+	fieldName := string(fieldDescriptor.Name())
+	for language, messageMembers := range languageMessageMembers {
+		if !slices.Contains(options.validLanguages, strings.ToLower(language)) {
+			// Skip languages that aren't enabled.
+			continue
+		}
+		if slices.Contains(messageMembers, fieldName) {
+			responseWriter.AddAnnotation(
+				check.WithMessagef(
+					"Field name %q should not shadow the %s message member %q.",
+					fieldName,
+					language,
+					fieldName,
+				),
+				check.WithDescriptor(fieldDescriptor),
+			)
+		}
+	}
+	return nil
+}
+
+func checkPHPReservedClassNames(
+	_ context.Context,
+	responseWriter check.ResponseWriter,
+	request check.Request,
+	fileDescriptor descriptor.FileDescriptor,
+) error {
+	options, err := getOptions(request)
+	if err != nil {
+		return fmt.Errorf("parsing options: %w", err)
+	}
+	if !slices.Contains(options.validLanguages, "php") {
+		return nil
+	}
+	isReservedClassName := func(name string) bool {
+		return slices.Contains(phpReservedClassNames, strings.ToLower(name))
+	}
+	protoreflectFileDescriptor := fileDescriptor.ProtoreflectFileDescriptor()
+	packageName := string(protoreflectFileDescriptor.Package())
+	// This mirrors protoc-gen-php's prefixing of reserved class names.
+	// https://github.com/protocolbuffers/protobuf/blob/v29.0/src/google/protobuf/compiler/php/names.cc
+	reservedNamePrefix := "PB"
+	if packageName == "google.protobuf" {
+		reservedNamePrefix = "GPB"
+	}
+	fileOptions := fileDescriptor.FileDescriptorProto().GetOptions()
+	if packageName != "" && fileOptions.GetPhpNamespace() == "" {
+		// protoc-gen-php uppercases the first letter of each package component, leaving
+		// the rest as is.
+		var namespaceComponents []string
+		var reservedComponent string
+		for component := range strings.SplitSeq(packageName, ".") {
+			component = strings.ToUpper(component[:1]) + component[1:]
+			if isReservedClassName(component) {
+				if reservedComponent == "" {
+					reservedComponent = component
+				}
+				component = reservedNamePrefix + component
+			}
+			namespaceComponents = append(namespaceComponents, component)
+		}
+		if reservedComponent != "" {
+			responseWriter.AddAnnotation(
+				check.WithMessagef(
+					"Package name %q is generated as PHP namespace %q by protoc-gen-php, as %q is a reserved PHP class name.",
+					packageName,
+					strings.Join(namespaceComponents, `\`),
+					reservedComponent,
+				),
+				check.WithFileNameAndSourcePath(*fileDescriptor.FileDescriptorProto().Name, []int32{2}),
+			)
+		}
+	}
+	if fileOptions.GetPhpClassPrefix() != "" {
+		// The class prefix is used instead, so reserved names don't need prefixing.
+		return nil
+	}
+	checkName := func(elementType string, descriptor protoreflect.Descriptor) {
+		name := string(descriptor.Name())
+		if !isReservedClassName(name) {
+			return
+		}
+		responseWriter.AddAnnotation(
+			check.WithMessagef(
+				"%s name %q is generated as PHP class %q by protoc-gen-php, as %q is a reserved PHP class name.",
+				elementType,
+				name,
+				reservedNamePrefix+name,
+				name,
+			),
+			check.WithDescriptor(descriptor),
+		)
+	}
+	checkEnums := func(enums protoreflect.EnumDescriptors) {
+		for i := range enums.Len() {
+			checkName("Enum", enums.Get(i))
+		}
+	}
+	checkEnums(protoreflectFileDescriptor.Enums())
+	forEachMessage(
+		protoreflectFileDescriptor.Messages(),
+		func(messageDescriptor protoreflect.MessageDescriptor) {
+			if isMapEntry(messageDescriptor) {
+				return
+			}
+			checkName("Message", messageDescriptor)
+			checkEnums(messageDescriptor.Enums())
+		},
+	)
+	return nil
+}
+
+func checkTypeScriptGeneratedNameCollisions(
+	_ context.Context,
+	responseWriter check.ResponseWriter,
+	request check.Request,
+	fileDescriptor descriptor.FileDescriptor,
+) error {
+	options, err := getOptions(request)
+	if err != nil {
+		return fmt.Errorf("parsing options: %w", err)
+	}
+	typeScriptEnabled := slices.Contains(options.validLanguages, "typescript")
+	if !typeScriptEnabled && !slices.Contains(options.validLanguages, "javascript") {
+		return nil
+	}
+	checkPropertyName := func(elementType string, descriptor protoreflect.Descriptor) {
+		name := string(descriptor.Name())
+		// protobuf-es keeps the case of the first letter, so a field named Constructor
+		// isn't renamed.
+		propertyName := protobufESCamelCase(name)
+		if !slices.Contains(protobufESReservedPropertyNames, propertyName) {
+			return
+		}
+		responseWriter.AddAnnotation(
+			check.WithMessagef(
+				"%s name %q is generated as property %q by protobuf-es to avoid colliding with the reserved %q property.",
+				elementType,
+				name,
+				propertyName+"$",
+				propertyName,
+			),
+			check.WithDescriptor(descriptor),
+		)
+	}
+	checkTypeName := func(elementType string, descriptor protoreflect.Descriptor) {
+		name := string(descriptor.Name())
+		if !typeScriptEnabled || !slices.Contains(tsProtoDeclarationNames, name) {
+			return
+		}
+		responseWriter.AddAnnotation(
+			check.WithMessagef(
+				"%s name %q collides with %q, which ts-proto declares in the files it generates.",
+				elementType,
+				name,
+				name,
+			),
+			check.WithDescriptor(descriptor),
+		)
+	}
+	protoreflectFileDescriptor := fileDescriptor.ProtoreflectFileDescriptor()
+	// ts-proto prefixes nested types with the names of their parents, such as Outer_Inner,
+	// so only top-level types can collide with its declarations.
+	enums := protoreflectFileDescriptor.Enums()
+	for i := range enums.Len() {
+		checkTypeName("Enum", enums.Get(i))
+	}
+	messages := protoreflectFileDescriptor.Messages()
+	for i := range messages.Len() {
+		checkTypeName("Message", messages.Get(i))
+	}
+	forEachMessage(
+		messages,
+		func(messageDescriptor protoreflect.MessageDescriptor) {
+			if isMapEntry(messageDescriptor) {
+				return
+			}
+			fields := messageDescriptor.Fields()
+			for i := range fields.Len() {
+				fieldDescriptor := fields.Get(i)
+				if oneofDescriptor := fieldDescriptor.ContainingOneof(); oneofDescriptor != nil && !oneofDescriptor.IsSynthetic() {
+					// Fields in oneofs are generated as cases of the oneof's property.
+					continue
+				}
+				checkPropertyName("Field", fieldDescriptor)
+			}
+			oneofs := messageDescriptor.Oneofs()
+			for i := range oneofs.Len() {
+				if oneofDescriptor := oneofs.Get(i); !oneofDescriptor.IsSynthetic() {
+					checkPropertyName("Oneof", oneofDescriptor)
+				}
+			}
+		},
+	)
+	return nil
+}
+
+func checkFieldNoLanguageReservedKeywords(
+	_ context.Context,
+	responseWriter check.ResponseWriter,
+	request check.Request,
+	fieldDescriptor protoreflect.FieldDescriptor,
+) error {
+	if fieldDescriptor.IsExtension() {
+		// Extensions are checked by checkExtensionNoLanguageReservedKeywords.
+		return nil
+	}
+	options, err := getOptions(request)
+	if err != nil {
+		return fmt.Errorf("parsing options: %w", err)
+	}
+	checkNameNoLanguageReservedKeywords(responseWriter, options, "Field", "", fieldDescriptor)
+	return nil
+}
+
+func checkExtensionNoLanguageReservedKeywords(
+	_ context.Context,
+	responseWriter check.ResponseWriter,
+	request check.Request,
+	fieldDescriptor protoreflect.FieldDescriptor,
+) error {
+	if !fieldDescriptor.IsExtension() {
+		return nil
+	}
+	options, err := getOptions(request)
+	if err != nil {
+		return fmt.Errorf("parsing options: %w", err)
+	}
+	// Extensions generate identifiers scoped to whatever they're declared in, rather than
+	// the message they extend, so include that scope in the message.
+	var scope string
+	switch parent := fieldDescriptor.Parent().(type) {
+	case protoreflect.FileDescriptor:
+		scope = fmt.Sprintf("file %q", parent.Path())
+	default:
+		scope = fmt.Sprintf("message %q", parent.FullName())
+	}
+	checkNameNoLanguageReservedKeywords(responseWriter, options, "Extension", scope, fieldDescriptor)
+	return nil
+}
+
+func checkMessageNoLanguageReservedKeywords(
+	_ context.Context,
+	responseWriter check.ResponseWriter,
+	request check.Request,
+	messageDescriptor protoreflect.MessageDescriptor,
+) error {
+	if isMapEntry(messageDescriptor) {
+		return nil
+	}
+	options, err := getOptions(request)
+	if err != nil {
+		return fmt.Errorf("parsing options: %w", err)
+	}
+	checkNameNoLanguageReservedKeywords(responseWriter, options, "Message", "", messageDescriptor)
+	return nil
+}
+
+func checkEnumNoLanguageReservedKeywords(
+	_ context.Context,
+	responseWriter check.ResponseWriter,
+	request check.Request,
+	enumDescriptor protoreflect.EnumDescriptor,
+) error {
+	options, err := getOptions(request)
+	if err != nil {
+		return fmt.Errorf("parsing options: %w", err)
+	}
+	checkNameNoLanguageReservedKeywords(responseWriter, options, "Enum", "", enumDescriptor)
+	return nil
+}
+
+func checkEnumValueNoLanguageReservedKeywords(
+	_ context.Context,
+	responseWriter check.ResponseWriter,
+	request check.Request,
+	enumValueDescriptor protoreflect.EnumValueDescriptor,
+) error {
+	options, err := getOptions(request)
+	if err != nil {
+		return fmt.Errorf("parsing options: %w", err)
+	}
+	checkNameNoLanguageReservedKeywords(responseWriter, options, "Enum value", "", enumValueDescriptor)
+	enumValueName := string(enumValueDescriptor.Name())
+	enumName := string(enumValueDescriptor.Parent().Name())
+	strippedName, ok := stripEnumValuePrefix(enumName, enumValueName)
+	if !ok {
+		return nil
+	}
+	for language, nameTransform := range languageEnumValueNameTransforms {
+		if !slices.Contains(options.validLanguages, strings.ToLower(language)) {
+			// Skip languages that aren't enabled.
+			continue
+		}
+		if _, ok := options.nameMangler(language); ok {
+			// The generator's renames of the stripped name are reported above.
+			continue
+		}
+		generatedName := nameTransform.transform(strippedName)
+		if slices.Contains(options.languageReservedKeywords[language], generatedName) {
+			responseWriter.AddAnnotation(
+				check.WithMessagef(
+					"Enum value name %q should not use %s reserved keyword %q after %s.",
+					enumValueName,
+					language,
+					generatedName,
+					nameTransform.description,
+				),
+				check.WithDescriptor(enumValueDescriptor),
+			)
+		}
+	}
+	return nil
+}
+
+func checkServiceNoLanguageReservedKeywords(
+	_ context.Context,
+	responseWriter check.ResponseWriter,
+	request check.Request,
+	serviceDescriptor protoreflect.ServiceDescriptor,
+) error {
+	options, err := getOptions(request)
+	if err != nil {
+		return fmt.Errorf("parsing options: %w", err)
+	}
+	checkNameNoLanguageReservedKeywords(responseWriter, options, "Service", "", serviceDescriptor)
+	return nil
+}
+
+func checkMethodNoLanguageReservedKeywords(
+	_ context.Context,
+	responseWriter check.ResponseWriter,
+	request check.Request,
+	methodDescriptor protoreflect.MethodDescriptor,
+) error {
+	options, err := getOptions(request)
+	if err != nil {
+		return fmt.Errorf("parsing options: %w", err)
+	}
+	checkNameNoLanguageReservedKeywords(responseWriter, options, "Method", "", methodDescriptor)
+	methodName := string(methodDescriptor.Name())
+	generatedName := lowerCamelCase(methodName)
+	if generatedName == methodName {
+		// Already covered by the check above.
+		return nil
+	}
+	for _, language := range lowerCamelCaseMethodLanguages {
+		if !slices.Contains(options.validLanguages, strings.ToLower(language)) {
+			// Skip languages that aren't enabled.
+			continue
+		}
+		if slices.Contains(options.languageReservedKeywords[language], generatedName) {
+			responseWriter.AddAnnotation(
+				check.WithMessagef(
+					"Method name %q should not use %s reserved keyword %q after converting to lowerCamelCase.",
+					methodName,
+					language,
+					generatedName,
+				),
+				check.WithDescriptor(methodDescriptor),
+			)
+		}
+	}
+	return nil
+}
+
+func checkOneofNoLanguageReservedKeywords(
+	_ context.Context,
+	responseWriter check.ResponseWriter,
+	request check.Request,
+	oneofDescriptor protoreflect.OneofDescriptor,
+) error {
+	if oneofDescriptor.IsSynthetic() {
+		// Synthetic oneofs are created by the compiler for proto3 optional fields and
+		// don't generate any code of their own.
+		return nil
+	}
+	options, err := getOptions(request)
+	if err != nil {
+		return fmt.Errorf("parsing options: %w", err)
+	}
+	checkNameNoLanguageReservedKeywords(responseWriter, options, "Oneof", "", oneofDescriptor)
+	return nil
+}
+
+// checkNameNoLanguageReservedKeywords adds an annotation on descriptor for each enabled
+// language that reserves its name. elementType is used as a prefix for the annotation's
+// message, such as "Field" or "Message". scope, if set, describes where the element is
+// declared, such as `file "foo.proto"`.
+func checkNameNoLanguageReservedKeywords(
+	responseWriter check.ResponseWriter,
+	options *checkOptions,
+	elementType string,
+	scope string,
+	descriptor protoreflect.Descriptor,
+) {
+	name := string(descriptor.Name())
+	subject := fmt.Sprintf("%s name %q", elementType, name)
+	if scope != "" {
+		subject += " declared in " + scope
+	}
+	for language, reservedKeywords := range options.languageReservedKeywords {
+		if !slices.Contains(options.validLanguages, strings.ToLower(language)) {
+			// Skip languages that aren't enabled.
+			continue
+		}
+		if nameMangler, ok := options.nameMangler(language); ok {
+			// Only report the names the generator actually renames.
+			if reservedName, mangledName, ok := nameMangler.mangle(elementType, descriptor); ok {
+				responseWriter.AddAnnotation(
+					check.WithMessagef(
+						"%s should not use %s reserved name %q, which %s renames to %q.",
+						subject,
+						language,
+						reservedName,
+						nameMangler.generator,
+						mangledName,
+					),
+					check.WithDescriptor(descriptor),
+				)
+			}
+			continue
+		}
+		if slices.Contains(reservedKeywords, name) {
+			responseWriter.AddAnnotation(
+				check.WithMessagef(
+					"%s should not use %s reserved keyword %q.",
+					subject,
+					language,
+					name,
+				),
+				check.WithDescriptor(descriptor),
+			)
+		}
+	}
+}
+
+// forEachMessage calls f for each message in messages, including nested messages.
+func forEachMessage(messages protoreflect.MessageDescriptors, f func(protoreflect.MessageDescriptor)) {
+	for i := range messages.Len() {
+		messageDescriptor := messages.Get(i)
+		f(messageDescriptor)
+		forEachMessage(messageDescriptor.Messages(), f)
+	}
+}
+
+// isMapEntry returns true if messageDescriptor is a map entry message. These are
+// synthesized by the compiler from the map field's name, so the field check covers them.
+func isMapEntry(messageDescriptor protoreflect.MessageDescriptor) bool {
+	return messageDescriptor.IsMapEntry()
+}
+
+// stripEnumValuePrefix strips the UPPER_SNAKE_CASE form of enumName from the front of
+// enumValueName, the way generators that scope enum values to their enum do.
+//
+// It returns false if enumValueName doesn't have the prefix, or if stripping it wouldn't
+// leave a valid identifier, in which case generators keep the full name.
+func stripEnumValuePrefix(enumName string, enumValueName string) (string, bool) {
+	prefix := screamingSnakeCase(enumName) + "_"
+	if len(enumValueName) <= len(prefix) || !strings.EqualFold(enumValueName[:len(prefix)], prefix) {
+		return "", false
+	}
+	strippedName := enumValueName[len(prefix):]
+	if strippedName[0] >= '0' && strippedName[0] <= '9' {
+		return "", false
+	}
+	return strippedName, true
+}
+
+// screamingSnakeCase converts a CamelCase name to SCREAMING_SNAKE_CASE.
+func screamingSnakeCase(name string) string {
+	var builder strings.Builder
+	for i, r := range name {
+		if i > 0 && unicode.IsUpper(r) && !unicode.IsUpper(rune(name[i-1])) && name[i-1] != '_' {
+			builder.WriteByte('_')
+		}
+		builder.WriteRune(unicode.ToUpper(r))
+	}
+	return builder.String()
+}
+
+// lowerCamelCase converts a snake_case, SCREAMING_SNAKE_CASE or CamelCase name to
+// lowerCamelCase.
+func lowerCamelCase(name string) string {
+	var builder strings.Builder
+	for i, word := range strings.Split(name, "_") {
+		if word == "" {
+			continue
+		}
+		if strings.ToUpper(word) == word {
+			word = strings.ToLower(word)
+		}
+		if i == 0 {
+			word = strings.ToLower(word[:1]) + word[1:]
+		} else {
+			word = strings.ToUpper(word[:1]) + word[1:]
+		}
+		builder.WriteString(word)
+	}
+	return builder.String()
+}
+
+// goPackageNameForGoPackage returns the Go package name protoc-gen-go uses for the given
+// go_package option value, prior to sanitization: either the explicit name following a
+// ';', or the last element of the import path.
+//
+// https://github.com/protocolbuffers/protobuf-go/blob/v1.36.11/compiler/protogen/protogen.go#L378
+func goPackageNameForGoPackage(goPackage string) string {
+	goImportPath, goPackageName, ok := strings.Cut(goPackage, ";")
+	if ok {
+		return goPackageName
+	}
+	return path.Base(goImportPath)
+}
+
+// goIdentName returns the Go identifier protoc-gen-go generates for a message or enum
+// descriptor: the goCamelCase form of its full name, relative to its package.
+func goIdentName(descriptor protoreflect.Descriptor) string {
+	name := string(descriptor.FullName())
+	if packageName := string(descriptor.ParentFile().Package()); packageName != "" {
+		name = strings.TrimPrefix(name, packageName+".")
+	}
+	return goCamelCase(name)
+}
+
+// goCamelCase mirrors protoc-gen-go's conversion of names to exported Go identifiers.
+//
+// https://github.com/protocolbuffers/protobuf-go/blob/v1.36.11/internal/strs/strings.go#L46
+func goCamelCase(name string) string {
+	var builder strings.Builder
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		switch {
+		case c == '.' && i+1 < len(name) && isASCIILower(name[i+1]):
+			// Skip over '.' in ".{{lowercase}}".
+		case c == '.':
+			builder.WriteByte('_')
+		case c == '_' && (i == 0 || name[i-1] == '.'):
+			// Convert an initial '_' to ensure the name starts with a capital letter.
+			builder.WriteByte('X')
+		case c == '_' && i+1 < len(name) && isASCIILower(name[i+1]):
+			// Skip over '_' in "_{{lowercase}}".
+		case '0' <= c && c <= '9':
+			builder.WriteByte(c)
+		default:
+			// The next word is a sequence of characters that must start upper case.
+			if isASCIILower(c) {
+				c -= 'a' - 'A'
+			}
+			builder.WriteByte(c)
+			// Accept the lower case sequence that follows.
+			for ; i+1 < len(name) && isASCIILower(name[i+1]); i++ {
+				builder.WriteByte(name[i+1])
+			}
+		}
+	}
+	return builder.String()
+}
+
+func isASCIILower(c byte) bool {
+	return 'a' <= c && c <= 'z'
+}
+
+// javaCamelCase mirrors protobuf-java's conversion of field names to CamelCase, which
+// starts a new word after each underscore or digit.
+//
+// https://github.com/protocolbuffers/protobuf/blob/v29.0/src/google/protobuf/compiler/java/helpers.cc#L135
+func javaCamelCase(name string, capitalizeFirstLetter bool) string {
+	var builder strings.Builder
+	capitalizeNextLetter := capitalizeFirstLetter
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		switch {
+		case 'a' <= c && c <= 'z':
+			if capitalizeNextLetter {
+				c -= 'a' - 'A'
+			}
+			builder.WriteByte(c)
+			capitalizeNextLetter = false
+		case 'A' <= c && c <= 'Z':
+			if i == 0 && !capitalizeFirstLetter {
+				c += 'a' - 'A'
+			}
+			builder.WriteByte(c)
+			capitalizeNextLetter = false
+		case '0' <= c && c <= '9':
+			builder.WriteByte(c)
+			capitalizeNextLetter = true
+		default:
+			capitalizeNextLetter = true
+		}
+	}
+	return builder.String()
+}
+
+// javaIsConflicting mirrors protobuf-java's detection of fields whose accessors conflict,
+// where name1 and name2 are the fields' names in CamelCase. It doesn't detect every
+// conflict, only the common ones.
+func javaIsConflicting(field1 protoreflect.FieldDescriptor, name1 string, field2 protoreflect.FieldDescriptor, name2 string) bool {
+	return name1 == name2 ||
+		javaIsConflictingOneWay(field1, name1, name2) ||
+		javaIsConflictingOneWay(field2, name2, name1)
+}
+
+func javaIsConflictingOneWay(field1 protoreflect.FieldDescriptor, name1 string, name2 string) bool {
+	if field1.IsList() && (name1+"Count" == name2 || name1+"List" == name2) {
+		return true
+	}
+	return field1.Kind() == protoreflect.EnumKind && !field1.Enum().IsClosed() && name1+"Value" == name2
+}
+
+// javaAccessors returns the no-argument accessors protobuf-java generates on a message
+// and its builder for fieldDescriptor, whose name in CamelCase is capitalizedName.
+// Accessors that take arguments are omitted, as Java allows them to be overloaded.
+func javaAccessors(fieldDescriptor protoreflect.FieldDescriptor, capitalizedName string) []string {
+	accessors := []string{
+		"clear" + capitalizedName,
+	}
+	switch {
+	case fieldDescriptor.IsMap():
+		accessors = append(
+			accessors,
+			// Deprecated, but still generated.
+			"get"+capitalizedName,
+			"get"+capitalizedName+"Map",
+			"get"+capitalizedName+"Count",
+			"getMutable"+capitalizedName,
+		)
+	case fieldDescriptor.IsList():
+		accessors = append(
+			accessors,
+			"get"+capitalizedName+"List",
+			"get"+capitalizedName+"Count",
+		)
+		switch fieldDescriptor.Kind() {
+		case protoreflect.EnumKind:
+			accessors = append(accessors, "get"+capitalizedName+"ValueList")
+		case protoreflect.MessageKind, protoreflect.GroupKind:
+			accessors = append(
+				accessors,
+				"get"+capitalizedName+"OrBuilderList",
+				"get"+capitalizedName+"BuilderList",
+			)
+		}
+	default:
+		accessors = append(accessors, "get"+capitalizedName)
+		if fieldDescriptor.HasPresence() {
+			accessors = append(accessors, "has"+capitalizedName)
+		}
+		switch fieldDescriptor.Kind() {
+		case protoreflect.EnumKind:
+			if !fieldDescriptor.Enum().IsClosed() {
+				accessors = append(accessors, "get"+capitalizedName+"Value")
+			}
+		case protoreflect.StringKind:
+			accessors = append(accessors, "get"+capitalizedName+"Bytes")
+		case protoreflect.MessageKind, protoreflect.GroupKind:
+			accessors = append(
+				accessors,
+				"get"+capitalizedName+"OrBuilder",
+				"get"+capitalizedName+"Builder",
+			)
+		}
+	}
+	return accessors
+}
+
+// csharpPascalCase mirrors protoc-gen-csharp's conversion of names to PascalCase, which
+// is the same as protobuf-java's.
+//
+// https://github.com/protocolbuffers/protobuf/blob/v29.0/src/google/protobuf/compiler/csharp/names.cc#L120
+func csharpPascalCase(name string) string {
+	return javaCamelCase(name, true)
+}
+
+// objcCamelCase mirrors protobuf-objc's conversion of field names to lowerCamelCase,
+// which splits words on underscores, digits and case changes, and uppercases some
+// acronyms.
+//
+// https://github.com/protocolbuffers/protobuf/blob/v29.0/src/google/protobuf/compiler/objectivec/names.cc#L95
+func objcCamelCase(name string) string {
+	var words []string
+	var current strings.Builder
+	var lastWasDigit, lastWasLower, lastWasUpper bool
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		switch {
+		case '0' <= c && c <= '9':
+			if !lastWasDigit {
+				words = append(words, current.String())
+				current.Reset()
+			}
+			current.WriteByte(c)
+			lastWasDigit, lastWasLower, lastWasUpper = true, false, false
+		case 'a' <= c && c <= 'z':
+			if !lastWasLower && !lastWasUpper {
+				words = append(words, current.String())
+				current.Reset()
+			}
+			current.WriteByte(c)
+			lastWasDigit, lastWasLower, lastWasUpper = false, true, false
+		case 'A' <= c && c <= 'Z':
+			if !lastWasUpper {
+				words = append(words, current.String())
+				current.Reset()
+			}
+			current.WriteByte(c + 'a' - 'A')
+			lastWasDigit, lastWasLower, lastWasUpper = false, false, true
+		default:
+			lastWasDigit, lastWasLower, lastWasUpper = false, false, false
+		}
+	}
+	words = append(words, current.String())
+	var builder strings.Builder
+	firstWordIsUpper := false
+	for _, word := range words {
+		if word == "" {
+			continue
+		}
+		if slices.Contains(objcUpperWords, word) {
+			if builder.Len() == 0 {
+				firstWordIsUpper = true
+			}
+			builder.WriteString(strings.ToUpper(word))
+			continue
+		}
+		builder.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+	result := builder.String()
+	if result == "" || firstWordIsUpper {
+		return result
+	}
+	return strings.ToLower(result[:1]) + result[1:]
+}
+
+// objcMethodFamily returns the ARC method family of name, if any. A name is only in a
+// family if the family's name is followed by a character that isn't lowercase, so
+// "newton" isn't in the "new" family, but "newTon" is.
+//
+// https://clang.llvm.org/docs/AutomaticReferenceCounting.html#method-families
+func objcMethodFamily(name string) (string, bool) {
+	for _, methodFamily := range objcMethodFamilies {
+		rest, ok := strings.CutPrefix(name, methodFamily)
+		if ok && (rest == "" || !isASCIILower(rest[0])) {
+			return methodFamily, true
+		}
+	}
+	return "", false
+}
+
+// dartCamelCase mirrors protoc-gen-dart's conversion of field names to lowerCamelCase,
+// which capitalizes the first letter after each underscore, then lowercases the first
+// letter.
+//
+// https://github.com/google/protobuf.dart/blob/protoc_plugin-v21.1.2/protoc_plugin/lib/names.dart
+func dartCamelCase(name string) string {
+	var builder strings.Builder
+	for word := range strings.SplitSeq(name, "_") {
+		if word == "" {
+			continue
+		}
+		builder.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+	result := builder.String()
+	if result == "" {
+		return result
+	}
+	return strings.ToLower(result[:1]) + result[1:]
+}
+
+// protobufESCamelCase mirrors protobuf-es's conversion of names to lowerCamelCase, which
+// drops underscores and capitalizes the letter following them, leaving the rest as is.
+//
+// https://github.com/bufbuild/protobuf-es/blob/v2.2.3/packages/protobuf/src/reflect/names.ts
+func protobufESCamelCase(name string) string {
+	var builder strings.Builder
+	capitalizeNextLetter := false
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		switch {
+		case c == '_':
+			capitalizeNextLetter = true
+		case '0' <= c && c <= '9':
+			builder.WriteByte(c)
+			capitalizeNextLetter = false
+		default:
+			if capitalizeNextLetter && isASCIILower(c) {
+				c -= 'a' - 'A'
+			}
+			builder.WriteByte(c)
+			capitalizeNextLetter = false
+		}
+	}
+	return builder.String()
+}
+
+// cppFieldName mirrors protoc's naming of C++ field accessors, which lowercases the name
+// and appends an underscore to keywords.
+//
+// https://github.com/protocolbuffers/protobuf/blob/v29.0/src/google/protobuf/compiler/cpp/helpers.cc#L434
+func cppFieldName(name string) string {
+	name = strings.ToLower(name)
+	if slices.Contains(languageReservedKeywords["C++"], name) {
+		name += "_"
+	}
+	return name
+}
+
+// cppFieldMembers returns the public members protoc generates on a C++ message for
+// fieldDescriptor.
+func cppFieldMembers(fieldDescriptor protoreflect.FieldDescriptor) []string {
+	name := cppFieldName(string(fieldDescriptor.Name()))
+	members := []string{
+		name,
+		"clear_" + name,
+	}
+	switch {
+	case fieldDescriptor.IsMap():
+		members = append(
+			members,
+			name+"_size",
+			"mutable_"+name,
+		)
+	case fieldDescriptor.IsList():
+		members = append(
+			members,
+			name+"_size",
+			"add_"+name,
+			"set_"+name,
+			"mutable_"+name,
+		)
+	default:
+		members = append(members, "set_"+name)
+		if fieldDescriptor.HasPresence() {
+			members = append(members, "has_"+name)
+		}
+		switch fieldDescriptor.Kind() {
+		case protoreflect.StringKind, protoreflect.BytesKind, protoreflect.MessageKind, protoreflect.GroupKind:
+			members = append(
+				members,
+				"mutable_"+name,
+				"release_"+name,
+				"set_allocated_"+name,
+			)
+		}
+	}
+	return members
+}
+
+// goSanitized mirrors protoc-gen-go's sanitization of Go package names: invalid characters
+// are replaced with '_', and a '_' is prepended to keywords and names that don't start
+// with a letter.
+//
+// https://github.com/protocolbuffers/protobuf-go/blob/v1.36.11/internal/strs/strings.go#L20
+func goSanitized(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return '_'
+	}, name)
+	r, _ := utf8.DecodeRuneInString(name)
+	if token.IsKeyword(name) || !unicode.IsLetter(r) {
+		return "_" + name
+	}
+	return name
+}
+
+// checkOptions are the options a check.Request was made with.
+type checkOptions struct {
+	// validLanguages are the lowercased names of the languages to check.
+	validLanguages []string
+	// languageReservedKeywords contains the reserved keywords to check for each language,
+	// taking unescapableKeywordsOnlyLanguagesOptionKey into account.
+	languageReservedKeywords map[string][]string
+	// unescapableKeywordsOnlyLanguages are the names of the languages given to
+	// unescapableKeywordsOnlyLanguagesOptionKey.
+	unescapableKeywordsOnlyLanguages []string
+}
+
+// nameMangler returns the nameMangler for language if only the names its generator
+// renames should be reported.
+func (o *checkOptions) nameMangler(language string) (nameMangler, bool) {
+	if !slices.Contains(o.unescapableKeywordsOnlyLanguages, language) {
+		return nameMangler{}, false
+	}
+	nameMangler, ok := languageNameManglers[language]
+	return nameMangler, ok
+}
+
+func getOptions(request check.Request) (*checkOptions, error) {
+	// Default to all languages being enabled.
+	validLanguages := make([]string, 0, len(languageReservedKeywords))
+	for language := range languageReservedKeywords {
+		validLanguages = append(validLanguages, strings.ToLower(language))
+	}
+	enabledLanguagesOptionKey, err := option.GetStringSliceValue(request.Options(), enabledLanguagesOptionKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(enabledLanguagesOptionKey) != 0 {
+		for _, optionLanguage := range enabledLanguagesOptionKey {
+			if !slices.Contains(validLanguages, optionLanguage) {
+				return nil, fmt.Errorf("invalid language given %q, expected one of: %q", optionLanguage, strings.Join(validLanguages, ", "))
+			}
+		}
+		// Use the specified languages instead.
+		validLanguages = enabledLanguagesOptionKey
+	}
+	unescapableKeywordsOnlyLanguages, err := option.GetStringSliceValue(request.Options(), unescapableKeywordsOnlyLanguagesOptionKey)
+	if err != nil {
+		return nil, err
+	}
+	reservedKeywords := maps.Clone(languageReservedKeywords)
+	unescapableLanguages := make([]string, 0, len(unescapableKeywordsOnlyLanguages))
+	for _, optionLanguage := range unescapableKeywordsOnlyLanguages {
+		language, ok := languageForLowercaseName(languageUnescapableKeywords, optionLanguage)
+		if !ok {
+			supportedLanguages := make([]string, 0, len(languageUnescapableKeywords))
+			for language := range languageUnescapableKeywords {
+				supportedLanguages = append(supportedLanguages, strings.ToLower(language))
+			}
+			slices.Sort(supportedLanguages)
+			return nil, fmt.Errorf(
+				"invalid language given %q for %s, expected one of: %q",
+				optionLanguage,
+				unescapableKeywordsOnlyLanguagesOptionKey,
+				strings.Join(supportedLanguages, ", "),
+			)
+		}
+		reservedKeywords[language] = languageUnescapableKeywords[language]
+		unescapableLanguages = append(unescapableLanguages, language)
+	}
+	return &checkOptions{
+		validLanguages:                   validLanguages,
+		languageReservedKeywords:         reservedKeywords,
+		unescapableKeywordsOnlyLanguages: unescapableLanguages,
+	}, nil
+}
+
+// languageForLowercaseName returns the key of languageMap whose lowercased form is
+// lowercaseName.
+func languageForLowercaseName[V any](languageMap map[string]V, lowercaseName string) (string, bool) {
+	for language := range languageMap {
+		if strings.ToLower(language) == lowercaseName {
+			return language, true
+		}
+	}
+	return "", false
+}
+
+// swiftProtobufMangledName returns the name SwiftProtobuf generates for an element whose
+// name collides with a name it reserves, along with the reserved name. It returns false
+// if SwiftProtobuf uses the name as-is, escaping it with backticks if needed.
+//
+// Services and methods always return false, as SwiftProtobuf doesn't generate code for
+// them, and the gRPC generators that do escape keywords with backticks.
+//
+// https://github.com/apple/swift-protobuf/blob/main/Sources/SwiftProtobufPluginLibrary/NamingUtils.swift
+func swiftProtobufMangledName(elementType string, descriptor protoreflect.Descriptor) (string, string, bool) {
+	name := string(descriptor.Name())
+	switch elementType {
+	case "Message", "Enum":
+		if !slices.Contains(languageReservedKeywords["Swift"], name) &&
+			!slices.Contains(swiftProtobufReservedTypeNames, name) {
+			return "", "", false
+		}
+		// Types are suffixed with their kind, such as TypeMessage or TypeEnum.
+		return name, name + elementType, true
+	case "Field", "Oneof", "Extension":
+		// Extensions are generated as properties on the message they extend.
+		propertyName := lowerCamelCase(name)
+		if !slices.Contains(swiftProtobufReservedFieldNames, propertyName) {
+			return "", "", false
+		}
+		return propertyName, propertyName + "_p", true
+	case "Enum value":
+		// Enum cases are named without the enum name prefix, if present.
+		if strippedName, ok := stripEnumValuePrefix(string(descriptor.Parent().Name()), name); ok {
+			name = strippedName
+		}
+		caseName := lowerCamelCase(name)
+		if !slices.Contains(swiftProtobufReservedEnumCaseNames, caseName) {
+			return "", "", false
+		}
+		return caseName, caseName + "_", true
+	default:
+		return "", "", false
+	}
+}
+
+// packageOption is a file option that, when set, replaces the package for the languages
+// generated from it.
+type packageOption struct {
+	// name is the option's name, as written in a .proto file.
+	name string
+	// fieldNumber is the option's field number in google.protobuf.FileOptions.
+	fieldNumber int32
+	// separator separates the option value's components.
+	separator string
+	// languages are the languages whose package is taken from the option.
+	languages []string
+	// getValue returns the option's value, or the empty string if it's unset.
+	getValue func(*descriptorpb.FileOptions) string
+	// caseInsensitive is set if the option's components are matched against keywords
+	// case-insensitively.
+	caseInsensitive bool
+}
+
+// reservedIdentifierPattern is a set of identifiers a language reserves that can't be
+// expressed as a fixed list of keywords.
+type reservedIdentifierPattern struct {
+	// description completes the sentence "identifiers ... are reserved".
+	description string
+	pattern     *regexp.Regexp
+}
+
+// cPreprocessorMacro is a macro commonly defined by C and C++ system headers or compilers,
+// which will expand any generated identifier of the same name.
+type cPreprocessorMacro struct {
+	name string
+	// platforms describes where the macro is defined.
+	platforms []string
+}
+
+// nameMangler describes how a language's generator renames elements whose names it can't
+// escape.
+type nameMangler struct {
+	// generator is the name of the generator that mangles names.
+	generator string
+	// mangle returns the reserved name that descriptor's name collides with, and the name
+	// the generator renames it to. It returns false if the generator uses the name as-is.
+	//
+	// elementType is the annotation prefix passed to checkNameNoLanguageReservedKeywords,
+	// such as "Field" or "Message".
+	mangle func(elementType string, descriptor protoreflect.Descriptor) (string, string, bool)
+}
+
+// enumValueNameTransform describes how a language's generator derives an enum value's
+// identifier from its prefix-stripped name.
+type enumValueNameTransform struct {
+	description string
+	transform   func(string) string
+}
+
+var (
+	// languageEnumValueNameTransforms contains the languages whose commonly used
+	// generators strip the enum name prefix from enum values.
+	languageEnumValueNameTransforms = map[string]enumValueNameTransform{
+		// https://github.com/apple/swift-protobuf/blob/main/Documentation/API.md#enums
+		"Swift": {
+			description: "stripping the enum name prefix and converting to lowerCamelCase",
+			transform:   lowerCamelCase,
+		},
+		// https://github.com/bufbuild/protobuf-es/blob/main/MANUAL.md#enumerations
+		"JavaScript": {
+			description: "stripping the enum name prefix",
+			transform:   func(name string) string { return name },
+		},
+		"TypeScript": {
+			description: "stripping the enum name prefix",
+			transform:   func(name string) string { return name },
+		},
+	}
+
+	// languagePredeclaredIdentifiers contains the identifiers each language implicitly
+	// declares, which aren't keywords but are shadowed by a package of the same name.
+	// Unlike languageReservedKeywords, these are only checked against package names.
+	languagePredeclaredIdentifiers = map[string][]string{
+		// https://go.dev/ref/spec#Predeclared_identifiers
+		"Go": {
+			// Types
+			"any",
+			"bool",
+			"byte",
+			"comparable",
+			"complex64",
+			"complex128",
+			"error",
+			"float32",
+			"float64",
+			"int",
+			"int8",
+			"int16",
+			"int32",
+			"int64",
+			"rune",
+			"string",
+			"uint",
+			"uint8",
+			"uint16",
+			"uint32",
+			"uint64",
+			"uintptr",
+			// Constants
+			"true",
+			"false",
+			"iota",
+			// Zero value
+			"nil",
+			// Functions
+			"append",
+			"cap",
+			"clear",
+			"close",
+			"complex",
+			"copy",
+			"delete",
+			"imag",
+			"len",
+			"make",
+			"max",
+			"min",
+			"new",
+			"panic",
+			"print",
+			"println",
+			"real",
+			"recover",
+		},
+	}
+
+	// packageOptions contains the file options that replace the package for some
+	// languages.
+	//
+	// https://github.com/protocolbuffers/protobuf/blob/6556a4ea26f2273797f559ebad87df42cd540443/src/google/protobuf/descriptor.proto#L449
+	packageOptions = []packageOption{
+		{
+			name:        "java_package",
+			fieldNumber: 1,
+			separator:   ".",
+			languages:   []string{"Java", "Kotlin"},
+			getValue:    (*descriptorpb.FileOptions).GetJavaPackage,
+		},
+		{
+			name:        "csharp_namespace",
+			fieldNumber: 37,
+			separator:   ".",
+			languages:   []string{"C#"},
+			getValue:    (*descriptorpb.FileOptions).GetCsharpNamespace,
+		},
+		{
+			name:        "php_namespace",
+			fieldNumber: 41,
+			separator:   `\`,
+			languages:   []string{"PHP"},
+			getValue:    (*descriptorpb.FileOptions).GetPhpNamespace,
+			// PHP keywords are case-insensitive.
+			caseInsensitive: true,
+		},
+		{
+			name:        "ruby_package",
+			fieldNumber: 45,
+			separator:   "::",
+			languages:   []string{"Ruby"},
+			getValue:    (*descriptorpb.FileOptions).GetRubyPackage,
+		},
+	}
+
+	// languageFilePathComponentTypes contains the languages whose generated code uses file
+	// path components as identifiers, along with the types of components it uses. Other
+	// languages take identifiers from the package or options such as go_package instead.
+	languageFilePathComponentTypes = map[string][]string{
+		// Directories become packages, such as foo/bar_pb2.py for foo/bar.proto. The
+		// file name is suffixed with _pb2, so it can't be a keyword.
+		// https://protobuf.dev/reference/python/python-generated/
+		"Python": {"directory"},
+		// The file name becomes the generated library's name, such as foo.pb.dart for
+		// foo.proto.
+		// https://github.com/google/protobuf.dart/tree/master/protoc_plugin
+		"Dart": {"file"},
+	}
+
+	// windowsReservedNames contains the device names that Windows won't allow as file or
+	// directory names, regardless of case or extension.
+	//
+	// https://learn.microsoft.com/en-us/windows/win32/fileio/naming-a-file#naming-conventions
+	windowsReservedNames = []string{
+		"CON",
+		"PRN",
+		"AUX",
+		"NUL",
+		"COM0",
+		"COM1",
+		"COM2",
+		"COM3",
+		"COM4",
+		"COM5",
+		"COM6",
+		"COM7",
+		"COM8",
+		"COM9",
+		"LPT0",
+		"LPT1",
+		"LPT2",
+		"LPT3",
+		"LPT4",
+		"LPT5",
+		"LPT6",
+		"LPT7",
+		"LPT8",
+		"LPT9",
+	}
+
+	// languageReservedIdentifierPatterns contains the identifiers reserved by each language
+	// beyond its keywords.
+	languageReservedIdentifierPatterns = map[string][]reservedIdentifierPattern{
+		// https://en.cppreference.com/w/c/language/identifier.html#Reserved_identifiers
+		"C": {
+			{
+				description: "beginning with an underscore followed by an uppercase letter or another underscore",
+				pattern:     regexp.MustCompile(`^_[A-Z_]`),
+			},
+		},
+		// https://en.cppreference.com/w/cpp/language/identifiers.html#In_declarations
+		"C++": {
+			{
+				description: "beginning with an underscore followed by an uppercase letter",
+				pattern:     regexp.MustCompile(`^_[A-Z]`),
+			},
+			{
+				description: "containing a double underscore",
+				pattern:     regexp.MustCompile(`__`),
+			},
+		},
+	}
+
+	// cPreprocessorLanguages contains the languages whose generated code is subject to
+	// cPreprocessorMacros.
+	cPreprocessorLanguages = []string{
+		"C",
+		"C++",
+	}
+
+	// cPreprocessorMacros contains well-known macros that aren't reserved keywords, but
+	// that break generated code by expanding identifiers of the same name.
+	cPreprocessorMacros = []cPreprocessorMacro{
+		// https://en.cppreference.com/w/c/types/NULL.html
+		{name: "NULL", platforms: []string{"the C standard library"}},
+		// https://en.cppreference.com/w/c/io.html
+		{name: "EOF", platforms: []string{"the C standard library"}},
+		{name: "stdin", platforms: []string{"the C standard library"}},
+		{name: "stdout", platforms: []string{"the C standard library"}},
+		{name: "stderr", platforms: []string{"the C standard library"}},
+		// https://en.cppreference.com/w/c/error/errno.html
+		{name: "errno", platforms: []string{"the C standard library"}},
+		// https://en.cppreference.com/w/c/error/assert.html
+		{name: "assert", platforms: []string{"the C standard library"}},
+		// https://en.cppreference.com/w/c/numeric/math/INFINITY.html
+		{name: "INFINITY", platforms: []string{"the C standard library"}},
+		{name: "NAN", platforms: []string{"the C standard library"}},
+		{name: "TRUE", platforms: []string{"Windows", "macOS"}},
+		{name: "FALSE", platforms: []string{"Windows", "macOS"}},
+		// https://man7.org/linux/man-pages/man3/matherr.3.html
+		{name: "DOMAIN", platforms: []string{"glibc", "Windows"}},
+		{name: "SING", platforms: []string{"glibc", "Windows"}},
+		{name: "OVERFLOW", platforms: []string{"glibc", "Windows"}},
+		{name: "UNDERFLOW", platforms: []string{"glibc", "Windows"}},
+		{name: "TLOSS", platforms: []string{"glibc", "Windows"}},
+		{name: "PLOSS", platforms: []string{"glibc", "Windows"}},
+		// https://man7.org/linux/man-pages/man3/makedev.3.html
+		{name: "major", platforms: []string{"glibc"}},
+		{name: "minor", platforms: []string{"glibc"}},
+		{name: "makedev", platforms: []string{"glibc"}},
+		// https://gcc.gnu.org/onlinedocs/cpp/System-specific-Predefined-Macros.html
+		{name: "linux", platforms: []string{"GCC"}},
+		{name: "unix", platforms: []string{"GCC"}},
+		// https://learn.microsoft.com/en-us/windows/win32/winprog/using-the-windows-headers
+		{name: "min", platforms: []string{"Windows"}},
+		{name: "max", platforms: []string{"Windows"}},
+		{name: "ERROR", platforms: []string{"Windows"}},
+		{name: "NO_ERROR", platforms: []string{"Windows"}},
+		{name: "DELETE", platforms: []string{"Windows"}},
+		{name: "IN", platforms: []string{"Windows"}},
+		{name: "OUT", platforms: []string{"Windows"}},
+		{name: "OPTIONAL", platforms: []string{"Windows"}},
+		{name: "GetMessage", platforms: []string{"Windows"}},
+		{name: "GetObject", platforms: []string{"Windows"}},
+		{name: "CreateFile", platforms: []string{"Windows"}},
+	}
+
+	// javaForbiddenFieldNames contains the CamelCase field names that protobuf-java renames
+	// with a trailing underscore, as their accessors would collide with inherited methods.
+	//
+	// https://github.com/protocolbuffers/protobuf/blob/v29.0/src/google/protobuf/compiler/java/names.cc#L38
+	javaForbiddenFieldNames = []string{
+		// java.lang.Object
+		"Class",
+		// com.google.protobuf.MessageLiteOrBuilder
+		"DefaultInstanceForType",
+		// com.google.protobuf.MessageLite
+		"ParserForType",
+		"SerializedSize",
+		// com.google.protobuf.MessageOrBuilder
+		"AllFields",
+		"DescriptorForType",
+		"InitializationErrorString",
+		"UnknownFields",
+		// Obsolete, but kept for backwards compatibility.
+		"CachedSize",
+	}
+
+	// javaInheritedMethods contains the no-argument methods on protobuf-java's generated
+	// messages and builders, beyond javaForbiddenFieldNames, that generated accessors can
+	// collide with.
+	javaInheritedMethods = []string{
+		"getDefaultInstance",
+		"getDescriptor",
+		"getParser",
+	}
+
+	// csharpMessageMembers contains the members declared or overridden by protoc-gen-csharp's
+	// generated classes, which generated properties are renamed to avoid.
+	//
+	// https://github.com/protocolbuffers/protobuf/blob/v29.0/src/google/protobuf/compiler/csharp/csharp_helpers.cc#L243
+	csharpMessageMembers = []string{
+		"CalculateSize",
+		"Clone",
+		"Descriptor",
+		"Equals",
+		"GetHashCode",
+		"MergeFrom",
+		"OnConstruction",
+		"Parser",
+		"ToString",
+		"Types",
+		"WriteTo",
+	}
+
+	// objcUpperWords contains the words that protobuf-objc uppercases in property names.
+	objcUpperWords = []string{
+		"http",
+		"https",
+		"url",
+	}
+
+	// objcMethodFamilies contains the ARC method families whose methods ARC assumes return
+	// retained objects. protobuf-objc marks getters in them as not doing so.
+	objcMethodFamilies = []string{
+		"alloc",
+		"copy",
+		"init",
+		"mutableCopy",
+		"new",
+	}
+
+	// objcNSObjectMembers contains the members of the NSObject protocol that protobuf-objc
+	// renames properties to avoid.
+	//
+	// https://developer.apple.com/documentation/objectivec/1418956-nsobject
+	objcNSObjectMembers = []string{
+		"autorelease",
+		"class",
+		"debugDescription",
+		"description",
+		"hash",
+		"isEqual",
+		"isKindOfClass",
+		"isMemberOfClass",
+		"isProxy",
+		"performSelector",
+		"release",
+		"respondsToSelector",
+		"retain",
+		"retainCount",
+		"self",
+		"superclass",
+		"zone",
+	}
+
+	// languageMessageMembers contains the members of messages in dynamic language runtimes,
+	// which fields of the same name shadow.
+	languageMessageMembers = map[string][]string{
+		// https://googleapis.dev/python/protobuf/latest/google/protobuf/message.html
+		"Python": {
+			"ByteSize",
+			"Clear",
+			"ClearExtension",
+			"ClearField",
+			"CopyFrom",
+			"DESCRIPTOR",
+			"DiscardUnknownFields",
+			"Extensions",
+			"FindInitializationErrors",
+			"FromString",
+			"HasExtension",
+			"HasField",
+			"IsInitialized",
+			"ListFields",
+			"MergeFrom",
+			"MergeFromString",
+			"ParseFromString",
+			"RegisterExtension",
+			"SerializePartialToString",
+			"SerializeToString",
+			"SetInParent",
+			"UnknownFields",
+			"WhichOneof",
+		},
+		// https://protobuf.dev/reference/ruby/ruby-generated/#message
+		"Ruby": {
+			"class",
+			"clone",
+			"dup",
+			"freeze",
+			"hash",
+			"inspect",
+			"method",
+			"methods",
+			"object_id",
+			"send",
+			"to_h",
+			"to_json",
+			"to_proto",
+			"to_s",
+		},
+	}
+
+	// phpReservedClassNames contains the lowercase names that protoc-gen-php prefixes
+	// when used as class names, regardless of case.
+	//
+	// https://github.com/protocolbuffers/protobuf/blob/v29.0/src/google/protobuf/compiler/php/names.cc
+	phpReservedClassNames = []string{
+		"abstract",
+		"and",
+		"array",
+		"as",
+		"bool",
+		"break",
+		"callable",
+		"case",
+		"catch",
+		"class",
+		"clone",
+		"const",
+		"continue",
+		"declare",
+		"default",
+		"die",
+		"do",
+		"echo",
+		"else",
+		"elseif",
+		"empty",
+		"enddeclare",
+		"endfor",
+		"endforeach",
+		"endif",
+		"endswitch",
+		"endwhile",
+		"eval",
+		"exit",
+		"extends",
+		"false",
+		"final",
+		"finally",
+		"float",
+		"fn",
+		"for",
+		"foreach",
+		"function",
+		"global",
+		"goto",
+		"if",
+		"implements",
+		"include",
+		"include_once",
+		"instanceof",
+		"insteadof",
+		"int",
+		"interface",
+		"isset",
+		"iterable",
+		"list",
+		"match",
+		"mixed",
+		"namespace",
+		"never",
+		"new",
+		"null",
+		"object",
+		"or",
+		"parent",
+		"print",
+		"private",
+		"protected",
+		"public",
+		"readonly",
+		"require",
+		"require_once",
+		"return",
+		"self",
+		"static",
+		"string",
+		"switch",
+		"throw",
+		"trait",
+		"true",
+		"try",
+		"unset",
+		"use",
+		"var",
+		"void",
+		"while",
+		"xor",
+		"yield",
+	}
+
+	// protobufESReservedPropertyNames contains the property names that protobuf-es escapes
+	// with a trailing "$", as they're used by JavaScript objects. Its other reserved names,
+	// such as $typeName, can't be produced by protobufESCamelCase.
+	//
+	// https://github.com/bufbuild/protobuf-es/blob/v2.2.3/packages/protobuf/src/reflect/names.ts
+	protobufESReservedPropertyNames = []string{
+		"constructor",
+		"toJSON",
+		"toString",
+		"valueOf",
+	}
+
+	// tsProtoDeclarationNames contains the names ts-proto declares or imports in the files
+	// it generates, alongside the generated types. It doesn't rename types that collide
+	// with them, so the generated code won't compile.
+	//
+	// https://github.com/stephenh/ts-proto/blob/main/src/main.ts
+	tsProtoDeclarationNames = []string{
+		"BinaryReader",
+		"BinaryWriter",
+		"Builtin",
+		"DeepPartial",
+		"Exact",
+		"KeysOfUnion",
+		"MessageFns",
+	}
+
+	// dartGeneratedMessageMembers contains the members of Object and protobuf.dart's
+	// GeneratedMessage that protoc-gen-dart renames fields to avoid, by appending the
+	// field number.
+	//
+	// https://github.com/google/protobuf.dart/blob/protoc_plugin-v21.1.2/protoc_plugin/lib/names.dart
+	dartGeneratedMessageMembers = []string{
+		"clear",
+		"clone",
+		"copyWith",
+		"createEmptyInstance",
+		"createRepeatedField",
+		"eventPlugin",
+		"extensionsAreInitialized",
+		"freeze",
+		"getDefaultForField",
+		"getExtension",
+		"getField",
+		"getFieldOrNull",
+		"getTagNumber",
+		"hasExtension",
+		"hasField",
+		"hasRequiredFields",
+		"hashCode",
+		"isFrozen",
+		"isInitialized",
+		"mergeFromBuffer",
+		"mergeFromCodedBufferReader",
+		"mergeFromJson",
+		"mergeFromJsonMap",
+		"mergeFromMessage",
+		"mergeFromProto3Json",
+		"mergeUnknownFields",
+		"noSuchMethod",
+		"rebuild",
+		"runtimeType",
+		"setExtension",
+		"setField",
+		"toBuilder",
+		"toDebugString",
+		"toProto3Json",
+		"toString",
+		"unknownFields",
+		"writeToBuffer",
+		"writeToCodedBufferWriter",
+		"writeToJson",
+		"writeToJsonMap",
+	}
+
+	// cppMessageMembers contains the lowercase members of protoc's generated C++ messages
+	// and their google::protobuf::Message and google::protobuf::MessageLite base classes.
+	// Members such as New, Swap and GetReflection aren't included, as protoc lowercases
+	// field names, so they can't collide.
+	cppMessageMembers = []string{
+		"default_instance",
+		"descriptor",
+		"internal_default_instance",
+		"mutable_unknown_fields",
+		"unknown_fields",
+	}
+
+	// lowerCamelCaseMethodLanguages contains the languages whose commonly used RPC
+	// generators emit method names in lowerCamelCase.
+	lowerCamelCaseMethodLanguages = []string{
+		"Dart",
+		"JavaScript",
+		"Swift",
+		"TypeScript",
+	}
+
+	// languageUnescapableKeywords contains the subset of each language's reserved keywords
+	// that its generators can't escape, so colliding with them is always an error.
+	languageUnescapableKeywords = map[string][]string{
+		// Rust keywords are escaped as raw identifiers, such as r#type, except for these.
+		// https://doc.rust-lang.org/reference/identifiers.html#raw-identifiers
+		"Rust": {
+			"_",
+			"crate",
+			"self",
+			"Self",
+			"super",
+		},
+		// protoc-gen-go exports every identifier it generates from an element's name, so Go
+		// keywords only need to be avoided in package names, which can't be escaped. As the
+		// package check doesn't use a nameMangler, it checks every keyword, while the
+		// no-op "Go" nameMangler skips every other element.
+		"Go": languageReservedKeywords["Go"],
+		// Swift keywords can be escaped with backticks, except for these, which can't be used
+		// as member names on generated types.
+		"Swift": {
+			"deinit",
+			"init",
+			"Protocol",
+			"self",
+			"Self",
+			"Type",
+		},
+	}
+
+	// languageNameManglers contains the languages whose generators rename elements rather
+	// than escaping them. When such a language is given to
+	// unescapableKeywordsOnlyLanguagesOptionKey, only the renamed elements are reported.
+	languageNameManglers = map[string]nameMangler{
+		// protoc-gen-go never renames elements because of keywords; the renames and
+		// collisions it does cause are checked by checkGoGeneratedNameCollisions.
+		"Go": {
+			generator: "protoc-gen-go",
+			mangle: func(string, protoreflect.Descriptor) (string, string, bool) {
+				return "", "", false
+			},
+		},
+		"Swift": {
+			generator: "SwiftProtobuf",
+			mangle:    swiftProtobufMangledName,
+		},
+	}
+
+	// swiftProtobufReservedTypeNames contains the names, in addition to the Swift
+	// keywords, that SwiftProtobuf won't use for generated types.
+	swiftProtobufReservedTypeNames = []string{
+		"Any",
+		"Array",
+		"Bool",
+		"Data",
+		"Dictionary",
+		"Double",
+		"Enum",
+		"Float",
+		"Int",
+		"Int32",
+		"Int64",
+		"Message",
+		"Optional",
+		"Protocol",
+		"Self",
+		"String",
+		"Type",
+		"UInt32",
+		"UInt64",
+	}
+
+	// swiftProtobufReservedFieldNames contains the property names that SwiftProtobuf
+	// won't use for generated fields, as they collide with generated or inherited
+	// members.
+	swiftProtobufReservedFieldNames = []string{
+		"debugDescription",
+		"deinit",
+		"description",
+		"dynamicType",
+		"hashValue",
+		"init",
+		"isInitialized",
+		"self",
+		"unknownFields",
+	}
+
+	// swiftProtobufReservedEnumCaseNames contains the case names that SwiftProtobuf won't
+	// use for generated enum values, as they collide with generated or inherited members.
+	swiftProtobufReservedEnumCaseNames = []string{
+		"debugDescription",
+		"deinit",
+		"description",
+		"dynamicType",
+		"hashValue",
+		"init",
+		"rawValue",
+		"self",
+	}
+
 	languageReservedKeywords = map[string][]string{
 		// https://en.cppreference.com/w/c/keyword.html
 		"C": {
@@ -399,6 +399,17 @@ func TestRule(t *testing.T) {
 						EndColumn:   20,
 					},
 				},
+				{
+					RuleID:  ruleIDPHPReservedClassNames,
+					Message: `Package name "function.v1" is generated as PHP namespace "PBFunction\\V1" by protoc-gen-php, as "Function" is a reserved PHP class name.`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "php.proto",
+						StartLine:   2,
+						StartColumn: 0,
+						EndLine:     2,
+						EndColumn:   20,
+					},
+				},
 			}
 			runCheckTest(t, requestSpec, want...)
 		})
@@ -577,51 +588,1411 @@ func TestRule(t *testing.T) {
 			}
 			runCheckTest(t, requestSpec, want...)
 		})
-	})
-	t.Run("valid", func(t *testing.T) {
-		t.Parallel()
-		requestSpec := newRequestSpec(
-			"testdata/correct",
-			[]string{"correct.proto"},
-			nil,
-		)
-		runCheckTest(t, requestSpec)
-	})
-	t.Run("options", func(t *testing.T) {
-		t.Parallel()
-		t.Run("enabled_languages", func(t *testing.T) {
-			t.Run("invalid", func(t *testing.T) {
-				requestSpec := newRequestSpec(
-					"testdata/correct",
-					[]string{"correct.proto"},
-					map[string]any{
-						"enabled_languages": []string{"invalid"},
+		t.Run("message", func(t *testing.T) {
+			t.Parallel()
+			requestSpec := newRequestSpec(
+				"testdata/message",
+				[]string{"message.proto"},
+				map[string]any{
+					"enabled_languages": []string{"objective-c", "swift"},
+				},
+			)
+			want := []checktest.ExpectedAnnotation{
+				{
+					RuleID:  ruleIDMessageNoLanguageReservedKeywords,
+					Message: `Message name "protocol" should not use Objective-C reserved keyword "protocol".`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "message.proto",
+						StartLine:   4,
+						StartColumn: 0,
+						EndLine:     7,
+						EndColumn:   1,
 					},
-				)
-
-				ctx := t.Context()
-				request, err := requestSpec.ToRequest(ctx)
-				ok.MustNoError(t, err)
-				client, err := check.NewClientForSpec(spec)
-				ok.MustNoError(t, err)
-				_, err = client.Check(ctx, request)
-				// Just check the prefix, so this doesn't fail as we add new supported
-				// languages. ErrorContains reports a nil error itself, so the
-				// separate "expected error" check would only have been a way to
-				// panic on err.Error() below.
-				const want = `Failed with code unknown: parsing options: invalid language given "invalid", expected one of:`
-				ok.ErrorContains(t, err, want)
-			})
-			t.Run("valid", func(t *testing.T) {
-				requestSpec := newRequestSpec(
-					"testdata/correct",
-					[]string{"correct.proto"},
-					map[string]any{
-						"enabled_languages": []string{"c", "c++", "c#", "dart", "go", "java", "javascript", "kotlin", "objective-c", "php", "python", "ruby", "rust", "scala", "swift", "typescript"},
+				},
+				{
+					RuleID:  ruleIDMessageNoLanguageReservedKeywords,
+					Message: `Message name "protocol" should not use Swift reserved keyword "protocol".`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "message.proto",
+						StartLine:   4,
+						StartColumn: 0,
+						EndLine:     7,
+						EndColumn:   1,
 					},
-				)
-
-				runCheckTest(t, requestSpec)
+				},
+				{
+					RuleID:  ruleIDMessageNoLanguageReservedKeywords,
+					Message: `Message name "Class" should not use Objective-C reserved keyword "Class".`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "message.proto",
+						StartLine:   5,
+						StartColumn: 2,
+						EndLine:     5,
+						EndColumn:   18,
+					},
+				},
+			}
+			runCheckTest(t, requestSpec, want...)
+		})
+		t.Run("enum", func(t *testing.T) {
+			t.Parallel()
+			requestSpec := newRequestSpec(
+				"testdata/enum",
+				[]string{"enum.proto"},
+				map[string]any{
+					"enabled_languages": []string{"swift"},
+				},
+			)
+			want := []checktest.ExpectedAnnotation{
+				{
+					RuleID:  ruleIDEnumNoLanguageReservedKeywords,
+					Message: `Enum name "default" should not use Swift reserved keyword "default".`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "enum.proto",
+						StartLine:   4,
+						StartColumn: 0,
+						EndLine:     6,
+						EndColumn:   1,
+					},
+				},
+				{
+					RuleID:  ruleIDEnumNoLanguageReservedKeywords,
+					Message: `Enum name "Self" should not use Swift reserved keyword "Self".`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "enum.proto",
+						StartLine:   9,
+						StartColumn: 2,
+						EndLine:     11,
+						EndColumn:   3,
+					},
+				},
+			}
+			runCheckTest(t, requestSpec, want...)
+		})
+		t.Run("enum_value", func(t *testing.T) {
+			t.Parallel()
+			requestSpec := newRequestSpec(
+				"testdata/enum_value",
+				[]string{"enum_value.proto"},
+				map[string]any{
+					"enabled_languages": []string{"swift"},
+				},
+			)
+			want := []checktest.ExpectedAnnotation{
+				{
+					RuleID:  ruleIDEnumValueNoLanguageReservedKeywords,
+					Message: `Enum value name "COLOR_DEFAULT" should not use Swift reserved keyword "default" after stripping the enum name prefix and converting to lowerCamelCase.`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "enum_value.proto",
+						StartLine:   6,
+						StartColumn: 2,
+						EndLine:     6,
+						EndColumn:   20,
+					},
+				},
+				{
+					RuleID:  ruleIDEnumValueNoLanguageReservedKeywords,
+					Message: `Enum value name "COLOR_IN" should not use Swift reserved keyword "in" after stripping the enum name prefix and converting to lowerCamelCase.`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "enum_value.proto",
+						StartLine:   7,
+						StartColumn: 2,
+						EndLine:     7,
+						EndColumn:   15,
+					},
+				},
+				{
+					RuleID:  ruleIDEnumValueNoLanguageReservedKeywords,
+					Message: `Enum value name "self" should not use Swift reserved keyword "self".`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "enum_value.proto",
+						StartLine:   8,
+						StartColumn: 2,
+						EndLine:     8,
+						EndColumn:   11,
+					},
+				},
+			}
+			runCheckTest(t, requestSpec, want...)
+		})
+		t.Run("service", func(t *testing.T) {
+			t.Parallel()
+			requestSpec := newRequestSpec(
+				"testdata/service",
+				[]string{"service.proto"},
+				map[string]any{
+					"enabled_languages": []string{"swift", "typescript"},
+				},
+			)
+			want := []checktest.ExpectedAnnotation{
+				{
+					RuleID:  ruleIDMethodNoLanguageReservedKeywords,
+					Message: `Method name "Delete" should not use TypeScript reserved keyword "delete" after converting to lowerCamelCase.`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "service.proto",
+						StartLine:   5,
+						StartColumn: 2,
+						EndLine:     5,
+						EndColumn:   36,
+					},
+				},
+				{
+					RuleID:  ruleIDMethodNoLanguageReservedKeywords,
+					Message: `Method name "in" should not use Swift reserved keyword "in".`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "service.proto",
+						StartLine:   6,
+						StartColumn: 2,
+						EndLine:     6,
+						EndColumn:   32,
+					},
+				},
+				{
+					RuleID:  ruleIDMethodNoLanguageReservedKeywords,
+					Message: `Method name "in" should not use TypeScript reserved keyword "in".`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "service.proto",
+						StartLine:   6,
+						StartColumn: 2,
+						EndLine:     6,
+						EndColumn:   32,
+					},
+				},
+				{
+					RuleID:  ruleIDServiceNoLanguageReservedKeywords,
+					Message: `Service name "Any" should not use Swift reserved keyword "Any".`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "service.proto",
+						StartLine:   4,
+						StartColumn: 0,
+						EndLine:     7,
+						EndColumn:   1,
+					},
+				},
+			}
+			runCheckTest(t, requestSpec, want...)
+		})
+		t.Run("oneof", func(t *testing.T) {
+			t.Parallel()
+			requestSpec := newRequestSpec(
+				"testdata/oneof",
+				[]string{"oneof.proto"},
+				map[string]any{
+					"enabled_languages": []string{"java"},
+				},
+			)
+			want := []checktest.ExpectedAnnotation{
+				{
+					RuleID:  ruleIDOneofNoLanguageReservedKeywords,
+					Message: `Oneof name "switch" should not use Java reserved keyword "switch".`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "oneof.proto",
+						StartLine:   5,
+						StartColumn: 2,
+						EndLine:     8,
+						EndColumn:   3,
+					},
+				},
+			}
+			runCheckTest(t, requestSpec, want...)
+		})
+		t.Run("extension", func(t *testing.T) {
+			t.Parallel()
+			requestSpec := newRequestSpec(
+				"testdata/extension",
+				[]string{"extension.proto"},
+				map[string]any{
+					"enabled_languages": []string{"java"},
+				},
+			)
+			want := []checktest.ExpectedAnnotation{
+				{
+					RuleID:  ruleIDExtensionNoLanguageReservedKeywords,
+					Message: `Extension name "for" declared in file "extension.proto" should not use Java reserved keyword "for".`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "extension.proto",
+						StartLine:   9,
+						StartColumn: 2,
+						EndLine:     9,
+						EndColumn:   28,
+					},
+				},
+				{
+					RuleID:  ruleIDExtensionNoLanguageReservedKeywords,
+					Message: `Extension name "while" declared in message "extension.v1.Scope" should not use Java reserved keyword "while".`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "extension.proto",
+						StartLine:   14,
+						StartColumn: 4,
+						EndLine:     14,
+						EndColumn:   32,
+					},
+				},
+			}
+			runCheckTest(t, requestSpec, want...)
+		})
+		t.Run("file_path", func(t *testing.T) {
+			t.Parallel()
+			requestSpec := newRequestSpec(
+				"testdata/file_path",
+				[]string{"class/v1/import.proto"},
+				map[string]any{
+					"enabled_languages": []string{"python"},
+				},
+			)
+			want := []checktest.ExpectedAnnotation{
+				{
+					RuleID:  ruleIDFilePathNoLanguageReservedKeywords,
+					Message: `File path "class/v1/import.proto" should not use Python reserved keyword "class" as a directory name.`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName: "class/v1/import.proto",
+					},
+				},
+			}
+			runCheckTest(t, requestSpec, want...)
+		})
+		t.Run("file_path_file_name_only", func(t *testing.T) {
+			t.Parallel()
+			requestSpec := newRequestSpec(
+				"testdata/file_path",
+				[]string{"class/v1/import.proto"},
+				map[string]any{
+					"enabled_languages": []string{"dart", "go"},
+				},
+			)
+			want := []checktest.ExpectedAnnotation{
+				{
+					RuleID:  ruleIDFilePathNoLanguageReservedKeywords,
+					Message: `File path "class/v1/import.proto" should not use Dart reserved keyword "import" as a file name.`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName: "class/v1/import.proto",
+					},
+				},
+			}
+			runCheckTest(t, requestSpec, want...)
+		})
+		t.Run("go_package", func(t *testing.T) {
+			t.Parallel()
+			requestSpec := newRequestSpec(
+				"testdata/go_package",
+				[]string{"invalid.proto", "keyword.proto", "override.proto", "predeclared.proto"},
+				map[string]any{
+					"enabled_languages": []string{"go"},
+				},
+			)
+			want := []checktest.ExpectedAnnotation{
+				{
+					RuleID:  ruleIDGoPackageNoGoReservedKeywords,
+					Message: `Go package name "foo-bar" derived from go_package "example.com/gen/foo-bar" is not a valid Go identifier; protoc-gen-go will rename it to "foo_bar".`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "invalid.proto",
+						StartLine:   4,
+						StartColumn: 0,
+						EndLine:     4,
+						EndColumn:   46,
+					},
+				},
+				{
+					RuleID:  ruleIDGoPackageNoGoReservedKeywords,
+					Message: `Go package name "type" derived from go_package "example.com/gen/type" is a Go reserved keyword; protoc-gen-go will rename it to "_type".`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "keyword.proto",
+						StartLine:   4,
+						StartColumn: 0,
+						EndLine:     4,
+						EndColumn:   43,
+					},
+				},
+				{
+					RuleID:  ruleIDGoPackageNoGoReservedKeywords,
+					Message: `Go package name "string" derived from go_package "example.com/gen/v1;string" should not shadow Go predeclared identifier "string".`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "predeclared.proto",
+						StartLine:   4,
+						StartColumn: 0,
+						EndLine:     4,
+						EndColumn:   48,
+					},
+				},
+			}
+			runCheckTest(t, requestSpec, want...)
+		})
+		t.Run("java_package", func(t *testing.T) {
+			t.Parallel()
+			requestSpec := newRequestSpec(
+				"testdata/java_package",
+				[]string{"java_package.proto"},
+				map[string]any{
+					"enabled_languages": []string{"java", "kotlin"},
+				},
+			)
+			want := []checktest.ExpectedAnnotation{
+				{
+					RuleID:  ruleIDPackageNoLanguageReservedKeywords,
+					Message: `Package name "com.acme.native.in.v1" from java_package should not use Java reserved keyword "native".`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "java_package.proto",
+						StartLine:   4,
+						StartColumn: 0,
+						EndLine:     4,
+						EndColumn:   46,
+					},
+				},
+				{
+					RuleID:  ruleIDPackageNoLanguageReservedKeywords,
+					Message: `Package name "com.acme.native.in.v1" from java_package should not use Kotlin reserved keyword "in".`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "java_package.proto",
+						StartLine:   4,
+						StartColumn: 0,
+						EndLine:     4,
+						EndColumn:   46,
+					},
+				},
+			}
+			runCheckTest(t, requestSpec, want...)
+		})
+		t.Run("package_options", func(t *testing.T) {
+			t.Parallel()
+			requestSpec := newRequestSpec(
+				"testdata/package_options",
+				[]string{"package_options.proto"},
+				map[string]any{
+					"enabled_languages": []string{"c#", "php", "ruby"},
+				},
+			)
+			want := []checktest.ExpectedAnnotation{
+				{
+					RuleID:  ruleIDPackageNoLanguageReservedKeywords,
+					Message: `Package name "Acme.event.V1" from csharp_namespace should not use C# reserved keyword "event".`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "package_options.proto",
+						StartLine:   4,
+						StartColumn: 0,
+						EndLine:     4,
+						EndColumn:   42,
+					},
+				},
+				{
+					RuleID:  ruleIDPackageNoLanguageReservedKeywords,
+					Message: `Package name "Acme\\List\\V1" from php_namespace should not use PHP reserved keyword "List".`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "package_options.proto",
+						StartLine:   5,
+						StartColumn: 0,
+						EndLine:     5,
+						EndColumn:   40,
+					},
+				},
+				{
+					RuleID:  ruleIDPackageNoLanguageReservedKeywords,
+					Message: `Package name "Acme::BEGIN::V1" from ruby_package should not use Ruby reserved keyword "BEGIN".`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "package_options.proto",
+						StartLine:   6,
+						StartColumn: 0,
+						EndLine:     6,
+						EndColumn:   40,
+					},
+				},
+			}
+			runCheckTest(t, requestSpec, want...)
+		})
+		t.Run("windows", func(t *testing.T) {
+			t.Parallel()
+			requestSpec := newRequestSpec(
+				"testdata/windows",
+				[]string{"con/aux.proto"},
+				map[string]any{
+					"enabled_languages": []string{"go", "java"},
+				},
+			)
+			want := []checktest.ExpectedAnnotation{
+				{
+					RuleID:  ruleIDNoWindowsReservedNames,
+					Message: `File path "con/aux.proto" should not use Windows reserved device name "aux.proto".`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName: "con/aux.proto",
+					},
+				},
+				{
+					RuleID:  ruleIDNoWindowsReservedNames,
+					Message: `File path "con/aux.proto" should not use Windows reserved device name "con".`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName: "con/aux.proto",
+					},
+				},
+				{
+					RuleID:  ruleIDNoWindowsReservedNames,
+					Message: `Package name "nul.v1" should not use Windows reserved device name "nul".`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "con/aux.proto",
+						StartLine:   2,
+						StartColumn: 0,
+						EndLine:     2,
+						EndColumn:   15,
+					},
+				},
+				{
+					RuleID:  ruleIDNoWindowsReservedNames,
+					Message: `Import path "example.com/gen/com1/v1" from go_package should not use Windows reserved device name "com1".`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "con/aux.proto",
+						StartLine:   4,
+						StartColumn: 0,
+						EndLine:     4,
+						EndColumn:   49,
+					},
+				},
+				{
+					RuleID:  ruleIDNoWindowsReservedNames,
+					Message: `Package name "com.acme.prn" from java_package should not use Windows reserved device name "prn".`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "con/aux.proto",
+						StartLine:   5,
+						StartColumn: 0,
+						EndLine:     5,
+						EndColumn:   37,
+					},
+				},
+			}
+			runCheckTest(t, requestSpec, want...)
+		})
+		t.Run("reserved_identifiers", func(t *testing.T) {
+			t.Parallel()
+			requestSpec := newRequestSpec(
+				"testdata/reserved_identifiers",
+				[]string{"reserved_identifiers.proto"},
+				map[string]any{
+					"enabled_languages": []string{"c", "c++"},
+				},
+			)
+			want := []checktest.ExpectedAnnotation{
+				{
+					RuleID:  ruleIDNoLanguageReservedIdentifiers,
+					Message: `Package name "_Internal.v1" should not use C reserved identifier "_Internal", as identifiers beginning with an underscore followed by an uppercase letter or another underscore are reserved.`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "reserved_identifiers.proto",
+						StartLine:   2,
+						StartColumn: 0,
+						EndLine:     2,
+						EndColumn:   21,
+					},
+				},
+				{
+					RuleID:  ruleIDNoLanguageReservedIdentifiers,
+					Message: `Package name "_Internal.v1" should not use C++ reserved identifier "_Internal", as identifiers beginning with an underscore followed by an uppercase letter are reserved.`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "reserved_identifiers.proto",
+						StartLine:   2,
+						StartColumn: 0,
+						EndLine:     2,
+						EndColumn:   21,
+					},
+				},
+				{
+					RuleID:  ruleIDNoLanguageReservedIdentifiers,
+					Message: `Message name "_Value" should not use C reserved identifier "_Value", as identifiers beginning with an underscore followed by an uppercase letter or another underscore are reserved.`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "reserved_identifiers.proto",
+						StartLine:   4,
+						StartColumn: 0,
+						EndLine:     6,
+						EndColumn:   1,
+					},
+				},
+				{
+					RuleID:  ruleIDNoLanguageReservedIdentifiers,
+					Message: `Message name "_Value" should not use C++ reserved identifier "_Value", as identifiers beginning with an underscore followed by an uppercase letter are reserved.`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "reserved_identifiers.proto",
+						StartLine:   4,
+						StartColumn: 0,
+						EndLine:     6,
+						EndColumn:   1,
+					},
+				},
+				{
+					RuleID:  ruleIDNoLanguageReservedIdentifiers,
+					Message: `Field name "foo__bar" should not use C++ reserved identifier "foo__bar", as identifiers containing a double underscore are reserved.`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "reserved_identifiers.proto",
+						StartLine:   5,
+						StartColumn: 2,
+						EndLine:     5,
+						EndColumn:   22,
+					},
+				},
+			}
+			runCheckTest(t, requestSpec, want...)
+		})
+		t.Run("c_macros", func(t *testing.T) {
+			t.Parallel()
+			requestSpec := newRequestSpec(
+				"testdata/c_macros",
+				[]string{"c_macros.proto"},
+				map[string]any{
+					"enabled_languages": []string{"c++"},
+				},
+			)
+			// The rule isn't enabled by default.
+			requestSpec.RuleIDs = []string{ruleIDNoCPreprocessorMacros}
+			want := []checktest.ExpectedAnnotation{
+				{
+					RuleID:  ruleIDNoCPreprocessorMacros,
+					Message: `Enum value name "NO_ERROR" should not collide with C++ preprocessor macro "NO_ERROR" defined by Windows.`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "c_macros.proto",
+						StartLine:   6,
+						StartColumn: 2,
+						EndLine:     6,
+						EndColumn:   15,
+					},
+				},
+				{
+					RuleID:  ruleIDNoCPreprocessorMacros,
+					Message: `Enum value name "OVERFLOW" should not collide with C++ preprocessor macro "OVERFLOW" defined by glibc, Windows.`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "c_macros.proto",
+						StartLine:   7,
+						StartColumn: 2,
+						EndLine:     7,
+						EndColumn:   15,
+					},
+				},
+				{
+					RuleID:  ruleIDNoCPreprocessorMacros,
+					Message: `Field name "major" should not collide with C++ preprocessor macro "major" defined by glibc.`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "c_macros.proto",
+						StartLine:   11,
+						StartColumn: 2,
+						EndLine:     11,
+						EndColumn:   18,
+					},
+				},
+				{
+					RuleID:  ruleIDNoCPreprocessorMacros,
+					Message: `Field name "minor" should not collide with C++ preprocessor macro "minor" defined by glibc.`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "c_macros.proto",
+						StartLine:   12,
+						StartColumn: 2,
+						EndLine:     12,
+						EndColumn:   18,
+					},
+				},
+			}
+			runCheckTest(t, requestSpec, want...)
+		})
+		t.Run("go_predeclared", func(t *testing.T) {
+			t.Parallel()
+			requestSpec := newRequestSpec(
+				"testdata/go_predeclared",
+				[]string{"go_predeclared.proto"},
+				map[string]any{
+					"enabled_languages": []string{"go"},
+				},
+			)
+			want := []checktest.ExpectedAnnotation{
+				{
+					RuleID:  ruleIDGoGeneratedNameCollisions,
+					Message: `Field name "string" is generated as "String_" by protoc-gen-go to avoid colliding with "String".`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "go_predeclared.proto",
+						StartLine:   5,
+						StartColumn: 2,
+						EndLine:     5,
+						EndColumn:   20,
+					},
+				},
+				{
+					RuleID:  ruleIDPackageNoLanguageReservedKeywords,
+					Message: `Package name "acme.error" should not use Go predeclared identifier "error".`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "go_predeclared.proto",
+						StartLine:   2,
+						StartColumn: 0,
+						EndLine:     2,
+						EndColumn:   19,
+					},
+				},
+			}
+			runCheckTest(t, requestSpec, want...)
+		})
+		t.Run("go_generated", func(t *testing.T) {
+			t.Parallel()
+			requestSpec := newRequestSpec(
+				"testdata/go_generated",
+				[]string{"go_generated.proto"},
+				map[string]any{
+					"enabled_languages": []string{"go"},
+				},
+			)
+			want := []checktest.ExpectedAnnotation{
+				{
+					RuleID:  ruleIDGoGeneratedNameCollisions,
+					Message: `Field name "string" is generated as "String_" by protoc-gen-go to avoid colliding with "String".`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "go_generated.proto",
+						StartLine:   7,
+						StartColumn: 2,
+						EndLine:     7,
+						EndColumn:   20,
+					},
+				},
+				{
+					RuleID:  ruleIDGoGeneratedNameCollisions,
+					Message: `Field name "proto_reflect" is generated as "ProtoReflect" by protoc-gen-go, which collides with the generated "ProtoReflect" method.`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "go_generated.proto",
+						StartLine:   8,
+						StartColumn: 2,
+						EndLine:     8,
+						EndColumn:   27,
+					},
+				},
+				{
+					RuleID:  ruleIDGoGeneratedNameCollisions,
+					Message: `Field name "XXX_Unrecognized" is generated as "XXX_Unrecognized" by protoc-gen-go, which uses the "XXX_" prefix reserved for generated code.`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "go_generated.proto",
+						StartLine:   9,
+						StartColumn: 2,
+						EndLine:     9,
+						EndColumn:   30,
+					},
+				},
+				{
+					RuleID:  ruleIDGoGeneratedNameCollisions,
+					Message: `Oneof name "descriptor" is generated as "Descriptor_" by protoc-gen-go to avoid colliding with "Descriptor".`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "go_generated.proto",
+						StartLine:   10,
+						StartColumn: 2,
+						EndLine:     12,
+						EndColumn:   3,
+					},
+				},
+				{
+					RuleID:  ruleIDGoGeneratedNameCollisions,
+					Message: `Oneof field name "nested" generates a wrapper type named "Test_Nested_" by protoc-gen-go to avoid colliding with nested type "Test_Nested".`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "go_generated.proto",
+						StartLine:   11,
+						StartColumn: 4,
+						EndLine:     11,
+						EndColumn:   22,
+					},
+				},
+			}
+			runCheckTest(t, requestSpec, want...)
+		})
+		t.Run("java_generated", func(t *testing.T) {
+			t.Parallel()
+			requestSpec := newRequestSpec(
+				"testdata/java_generated",
+				[]string{"java_generated.proto"},
+				map[string]any{
+					"enabled_languages": []string{"java"},
+				},
+			)
+			want := []checktest.ExpectedAnnotation{
+				{
+					RuleID:  ruleIDJavaGeneratedNameCollisions,
+					Message: `Field name "foo" is generated as "Foo1" in Java accessors by protobuf-java to avoid colliding with field "foo_count".`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "java_generated.proto",
+						StartLine:   5,
+						StartColumn: 2,
+						EndLine:     5,
+						EndColumn:   26,
+					},
+				},
+				{
+					RuleID:  ruleIDJavaGeneratedNameCollisions,
+					Message: `Field name "foo_count" is generated as "FooCount2" in Java accessors by protobuf-java to avoid colliding with field "foo".`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "java_generated.proto",
+						StartLine:   6,
+						StartColumn: 2,
+						EndLine:     6,
+						EndColumn:   22,
+					},
+				},
+				{
+					RuleID:  ruleIDJavaGeneratedNameCollisions,
+					Message: `Field name "unknown_fields" is generated as Java accessor "getUnknownFields_" by protobuf-java to avoid colliding with the inherited "getUnknownFields" method.`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "java_generated.proto",
+						StartLine:   7,
+						StartColumn: 2,
+						EndLine:     7,
+						EndColumn:   28,
+					},
+				},
+				{
+					RuleID:  ruleIDJavaGeneratedNameCollisions,
+					Message: `Field name "descriptor" generates Java accessor "getDescriptor", which collides with the inherited "getDescriptor" method.`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "java_generated.proto",
+						StartLine:   8,
+						StartColumn: 2,
+						EndLine:     8,
+						EndColumn:   24,
+					},
+				},
+				{
+					RuleID:  ruleIDJavaGeneratedNameCollisions,
+					Message: `Field name "bar_bytes" generates Java accessor "getBarBytes", which collides with the accessor generated for field "bar".`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "java_generated.proto",
+						StartLine:   10,
+						StartColumn: 2,
+						EndLine:     10,
+						EndColumn:   23,
+					},
+				},
+			}
+			runCheckTest(t, requestSpec, want...)
+		})
+		t.Run("cpp_generated", func(t *testing.T) {
+			t.Parallel()
+			requestSpec := newRequestSpec(
+				"testdata/cpp_generated",
+				[]string{"cpp_generated.proto"},
+				map[string]any{
+					"enabled_languages": []string{"c++"},
+				},
+			)
+			want := []checktest.ExpectedAnnotation{
+				{
+					RuleID:  ruleIDCppGeneratedNameCollisions,
+					Message: `Field name "foo_size" generates C++ member "foo_size", which collides with the member generated for field "foo".`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "cpp_generated.proto",
+						StartLine:   6,
+						StartColumn: 2,
+						EndLine:     6,
+						EndColumn:   21,
+					},
+				},
+				{
+					RuleID:  ruleIDCppGeneratedNameCollisions,
+					Message: `Field name "descriptor" generates C++ member "descriptor", which collides with the inherited "descriptor" member.`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "cpp_generated.proto",
+						StartLine:   7,
+						StartColumn: 2,
+						EndLine:     7,
+						EndColumn:   24,
+					},
+				},
+				{
+					RuleID:  ruleIDCppGeneratedNameCollisions,
+					Message: `Field name "bar_case" generates C++ member "bar_case", which collides with the member generated for oneof "bar".`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "cpp_generated.proto",
+						StartLine:   11,
+						StartColumn: 2,
+						EndLine:     11,
+						EndColumn:   21,
+					},
+				},
+			}
+			runCheckTest(t, requestSpec, want...)
+		})
+		t.Run("csharp_generated", func(t *testing.T) {
+			t.Parallel()
+			requestSpec := newRequestSpec(
+				"testdata/csharp_generated",
+				[]string{"csharp_generated.proto"},
+				map[string]any{
+					"enabled_languages": []string{"c#"},
+				},
+			)
+			want := []checktest.ExpectedAnnotation{
+				{
+					RuleID:  ruleIDCSharpGeneratedNameCollisions,
+					Message: `Message name "Types" generates C# class "Status.Types.Types" by protoc-gen-csharp, which collides with its enclosing "Types" class.`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "csharp_generated.proto",
+						StartLine:   5,
+						StartColumn: 2,
+						EndLine:     5,
+						EndColumn:   18,
+					},
+				},
+				{
+					RuleID:  ruleIDCSharpGeneratedNameCollisions,
+					Message: `Field name "status" is generated as C# property "Status_" by protoc-gen-csharp to avoid colliding with "Status".`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "csharp_generated.proto",
+						StartLine:   6,
+						StartColumn: 2,
+						EndLine:     6,
+						EndColumn:   20,
+					},
+				},
+				{
+					RuleID:  ruleIDCSharpGeneratedNameCollisions,
+					Message: `Field name "to_string" is generated as C# property "ToString_" by protoc-gen-csharp to avoid colliding with "ToString".`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "csharp_generated.proto",
+						StartLine:   7,
+						StartColumn: 2,
+						EndLine:     7,
+						EndColumn:   23,
+					},
+				},
+				{
+					RuleID:  ruleIDCSharpGeneratedNameCollisions,
+					Message: `Oneof name "result" generates C# member "ResultCase" by protoc-gen-csharp, which collides with "ResultCase".`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "csharp_generated.proto",
+						StartLine:   11,
+						StartColumn: 2,
+						EndLine:     13,
+						EndColumn:   3,
+					},
+				},
+			}
+			runCheckTest(t, requestSpec, want...)
+		})
+		t.Run("objc_generated", func(t *testing.T) {
+			t.Parallel()
+			requestSpec := newRequestSpec(
+				"testdata/objc_generated",
+				[]string{"objc_generated.proto"},
+				map[string]any{
+					"enabled_languages": []string{"objective-c"},
+				},
+			)
+			want := []checktest.ExpectedAnnotation{
+				{
+					RuleID:  ruleIDObjCGeneratedNameCollisions,
+					Message: `Field name "new_value" generates Objective-C property "newValue", whose getter is in the ARC "new" method family and needs protobuf-objc to override its ownership semantics.`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "objc_generated.proto",
+						StartLine:   5,
+						StartColumn: 2,
+						EndLine:     5,
+						EndColumn:   23,
+					},
+				},
+				{
+					RuleID:  ruleIDObjCGeneratedNameCollisions,
+					Message: `Field name "copy_id" generates Objective-C property "copyIdArray", whose getter is in the ARC "copy" method family and needs protobuf-objc to override its ownership semantics.`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "objc_generated.proto",
+						StartLine:   7,
+						StartColumn: 2,
+						EndLine:     7,
+						EndColumn:   30,
+					},
+				},
+				{
+					RuleID:  ruleIDObjCGeneratedNameCollisions,
+					Message: `Field name "init_time" generates Objective-C property "initTime", whose getter is in the ARC "init" method family and needs protobuf-objc to override its ownership semantics.`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "objc_generated.proto",
+						StartLine:   8,
+						StartColumn: 2,
+						EndLine:     8,
+						EndColumn:   23,
+					},
+				},
+				{
+					RuleID:  ruleIDObjCGeneratedNameCollisions,
+					Message: `Field name "mutable_copy" generates Objective-C property "mutableCopy", whose getter is in the ARC "mutableCopy" method family and needs protobuf-objc to override its ownership semantics.`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "objc_generated.proto",
+						StartLine:   9,
+						StartColumn: 2,
+						EndLine:     9,
+						EndColumn:   26,
+					},
+				},
+				{
+					RuleID:  ruleIDObjCGeneratedNameCollisions,
+					Message: `Field name "hash" is generated as Objective-C property "hash_p" by protobuf-objc to avoid colliding with the NSObject "hash" member.`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "objc_generated.proto",
+						StartLine:   10,
+						StartColumn: 2,
+						EndLine:     10,
+						EndColumn:   18,
+					},
+				},
+				{
+					RuleID:  ruleIDObjCGeneratedNameCollisions,
+					Message: `Field name "description" is generated as Objective-C property "description_p" by protobuf-objc to avoid colliding with the NSObject "description" member.`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "objc_generated.proto",
+						StartLine:   11,
+						StartColumn: 2,
+						EndLine:     11,
+						EndColumn:   25,
+					},
+				},
+			}
+			runCheckTest(t, requestSpec, want...)
+		})
+		t.Run("dart_generated", func(t *testing.T) {
+			t.Parallel()
+			requestSpec := newRequestSpec(
+				"testdata/dart_generated",
+				[]string{"dart_generated.proto"},
+				map[string]any{
+					"enabled_languages": []string{"dart"},
+				},
+			)
+			want := []checktest.ExpectedAnnotation{
+				{
+					RuleID:  ruleIDDartGeneratedNameCollisions,
+					Message: `Field name "hash_code" is generated as Dart field "hashCode_1" by protoc-gen-dart to avoid colliding with the inherited "hashCode" member.`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "dart_generated.proto",
+						StartLine:   5,
+						StartColumn: 2,
+						EndLine:     5,
+						EndColumn:   23,
+					},
+				},
+				{
+					RuleID:  ruleIDDartGeneratedNameCollisions,
+					Message: `Field name "to_string" is generated as Dart field "toString_2" by protoc-gen-dart to avoid colliding with the inherited "toString" member.`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "dart_generated.proto",
+						StartLine:   6,
+						StartColumn: 2,
+						EndLine:     6,
+						EndColumn:   23,
+					},
+				},
+				{
+					RuleID:  ruleIDDartGeneratedNameCollisions,
+					Message: `Field name "runtime_type" is generated as Dart field "runtimeType_3" by protoc-gen-dart to avoid colliding with the inherited "runtimeType" member.`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "dart_generated.proto",
+						StartLine:   7,
+						StartColumn: 2,
+						EndLine:     7,
+						EndColumn:   26,
+					},
+				},
+				{
+					RuleID:  ruleIDDartGeneratedNameCollisions,
+					Message: `Field name "clone" is generated as Dart field "clone_4" by protoc-gen-dart to avoid colliding with the inherited "clone" member.`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "dart_generated.proto",
+						StartLine:   8,
+						StartColumn: 2,
+						EndLine:     8,
+						EndColumn:   19,
+					},
+				},
+			}
+			runCheckTest(t, requestSpec, want...)
+		})
+		t.Run("message_members", func(t *testing.T) {
+			t.Parallel()
+			requestSpec := newRequestSpec(
+				"testdata/message_members",
+				[]string{"message_members.proto"},
+				map[string]any{
+					"enabled_languages": []string{"python", "ruby"},
+				},
+			)
+			// The rule isn't enabled by default.
+			requestSpec.RuleIDs = []string{ruleIDFieldNoLanguageMessageMembers}
+			want := []checktest.ExpectedAnnotation{
+				{
+					RuleID:  ruleIDFieldNoLanguageMessageMembers,
+					Message: `Field name "DESCRIPTOR" should not shadow the Python message member "DESCRIPTOR".`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "message_members.proto",
+						StartLine:   5,
+						StartColumn: 2,
+						EndLine:     5,
+						EndColumn:   24,
+					},
+				},
+				{
+					RuleID:  ruleIDFieldNoLanguageMessageMembers,
+					Message: `Field name "ListFields" should not shadow the Python message member "ListFields".`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "message_members.proto",
+						StartLine:   6,
+						StartColumn: 2,
+						EndLine:     6,
+						EndColumn:   24,
+					},
+				},
+				{
+					RuleID:  ruleIDFieldNoLanguageMessageMembers,
+					Message: `Field name "hash" should not shadow the Ruby message member "hash".`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "message_members.proto",
+						StartLine:   7,
+						StartColumn: 2,
+						EndLine:     7,
+						EndColumn:   18,
+					},
+				},
+				{
+					RuleID:  ruleIDFieldNoLanguageMessageMembers,
+					Message: `Field name "to_h" should not shadow the Ruby message member "to_h".`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "message_members.proto",
+						StartLine:   8,
+						StartColumn: 2,
+						EndLine:     8,
+						EndColumn:   18,
+					},
+				},
+			}
+			runCheckTest(t, requestSpec, want...)
+		})
+		t.Run("php_class_names", func(t *testing.T) {
+			t.Parallel()
+			requestSpec := newRequestSpec(
+				"testdata/php_class_names",
+				[]string{"php_class_names.proto"},
+				map[string]any{
+					"enabled_languages": []string{"php"},
+				},
+			)
+			want := []checktest.ExpectedAnnotation{
+				{
+					RuleID:  ruleIDPackageNoLanguageReservedKeywords,
+					Message: `Package name "php_class_names.list.v1" should not use PHP reserved keyword "list".`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "php_class_names.proto",
+						StartLine:   2,
+						StartColumn: 0,
+						EndLine:     2,
+						EndColumn:   32,
+					},
+				},
+				{
+					RuleID:  ruleIDPHPReservedClassNames,
+					Message: `Package name "php_class_names.list.v1" is generated as PHP namespace "Php_class_names\\PBList\\V1" by protoc-gen-php, as "List" is a reserved PHP class name.`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "php_class_names.proto",
+						StartLine:   2,
+						StartColumn: 0,
+						EndLine:     2,
+						EndColumn:   32,
+					},
+				},
+				{
+					RuleID:  ruleIDPHPReservedClassNames,
+					Message: `Message name "Empty" is generated as PHP class "PBEmpty" by protoc-gen-php, as "Empty" is a reserved PHP class name.`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "php_class_names.proto",
+						StartLine:   4,
+						StartColumn: 0,
+						EndLine:     4,
+						EndColumn:   16,
+					},
+				},
+				{
+					RuleID:  ruleIDPHPReservedClassNames,
+					Message: `Enum name "Iterable" is generated as PHP class "PBIterable" by protoc-gen-php, as "Iterable" is a reserved PHP class name.`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "php_class_names.proto",
+						StartLine:   7,
+						StartColumn: 2,
+						EndLine:     9,
+						EndColumn:   3,
+					},
+				},
+				{
+					RuleID:  ruleIDPHPReservedClassNames,
+					Message: `Enum name "Readonly" is generated as PHP class "PBReadonly" by protoc-gen-php, as "Readonly" is a reserved PHP class name.`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "php_class_names.proto",
+						StartLine:   12,
+						StartColumn: 0,
+						EndLine:     14,
+						EndColumn:   1,
+					},
+				},
+			}
+			runCheckTest(t, requestSpec, want...)
+		})
+		t.Run("typescript_generated", func(t *testing.T) {
+			t.Parallel()
+			requestSpec := newRequestSpec(
+				"testdata/typescript_generated",
+				[]string{"typescript_generated.proto"},
+				map[string]any{
+					"enabled_languages": []string{"typescript"},
+				},
+			)
+			want := []checktest.ExpectedAnnotation{
+				{
+					RuleID:  ruleIDTypeScriptGeneratedNameCollisions,
+					Message: `Field name "to_j_s_o_n" is generated as property "toJSON$" by protobuf-es to avoid colliding with the reserved "toJSON" property.`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "typescript_generated.proto",
+						StartLine:   5,
+						StartColumn: 2,
+						EndLine:     5,
+						EndColumn:   24,
+					},
+				},
+				{
+					RuleID:  ruleIDTypeScriptGeneratedNameCollisions,
+					Message: `Field name "value_of" is generated as property "valueOf$" by protobuf-es to avoid colliding with the reserved "valueOf" property.`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "typescript_generated.proto",
+						StartLine:   6,
+						StartColumn: 2,
+						EndLine:     6,
+						EndColumn:   22,
+					},
+				},
+				{
+					RuleID:  ruleIDTypeScriptGeneratedNameCollisions,
+					Message: `Oneof name "to_string" is generated as property "toString$" by protobuf-es to avoid colliding with the reserved "toString" property.`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "typescript_generated.proto",
+						StartLine:   7,
+						StartColumn: 2,
+						EndLine:     9,
+						EndColumn:   3,
+					},
+				},
+				{
+					RuleID:  ruleIDTypeScriptGeneratedNameCollisions,
+					Message: `Message name "DeepPartial" collides with "DeepPartial", which ts-proto declares in the files it generates.`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "typescript_generated.proto",
+						StartLine:   14,
+						StartColumn: 0,
+						EndLine:     14,
+						EndColumn:   22,
+					},
+				},
+				{
+					RuleID:  ruleIDTypeScriptGeneratedNameCollisions,
+					Message: `Enum name "Exact" collides with "Exact", which ts-proto declares in the files it generates.`,
+					FileLocation: &checktest.ExpectedFileLocation{
+						FileName:    "typescript_generated.proto",
+						StartLine:   16,
+						StartColumn: 0,
+						EndLine:     18,
+						EndColumn:   1,
+					},
+				},
+			}
+			runCheckTest(t, requestSpec, want...)
+		})
+	})
+	t.Run("valid", func(t *testing.T) {
+		t.Parallel()
+		requestSpec := newRequestSpec(
+			"testdata/correct",
+			[]string{"correct.proto"},
+			nil,
+		)
+		runCheckTest(t, requestSpec)
+	})
+	t.Run("options", func(t *testing.T) {
+		t.Parallel()
+		t.Run("enabled_languages", func(t *testing.T) {
+			t.Run("invalid", func(t *testing.T) {
+				requestSpec := newRequestSpec(
+					"testdata/correct",
+					[]string{"correct.proto"},
+					map[string]any{
+						"enabled_languages": []string{"invalid"},
+					},
+				)
+
+				ctx := t.Context()
+				request, err := requestSpec.ToRequest(ctx)
+				ok.MustNoError(t, err)
+				client, err := check.NewClientForSpec(spec)
+				ok.MustNoError(t, err)
+				_, err = client.Check(ctx, request)
+				// Just check the prefix, so this doesn't fail as we add new supported
+				// languages. ErrorContains reports a nil error itself, so the
+				// separate "expected error" check would only have been a way to
+				// panic on err.Error() below.
+				const want = `Failed with code unknown: parsing options: invalid language given "invalid", expected one of:`
+				ok.ErrorContains(t, err, want)
+			})
+			t.Run("valid", func(t *testing.T) {
+				requestSpec := newRequestSpec(
+					"testdata/correct",
+					[]string{"correct.proto"},
+					map[string]any{
+						"enabled_languages": []string{"c", "c++", "c#", "dart", "go", "java", "javascript", "kotlin", "objective-c", "php", "python", "ruby", "rust", "scala", "swift", "typescript"},
+					},
+				)
+
+				runCheckTest(t, requestSpec)
+			})
+		})
+		t.Run("unescapable_keywords_only_languages", func(t *testing.T) {
+			t.Run("invalid", func(t *testing.T) {
+				requestSpec := newRequestSpec(
+					"testdata/unescapable",
+					[]string{"unescapable.proto"},
+					map[string]any{
+						"unescapable_keywords_only_languages": []string{"python"},
+					},
+				)
+
+				ctx := t.Context()
+				request, err := requestSpec.ToRequest(ctx)
+				ok.MustNoError(t, err)
+				client, err := check.NewClientForSpec(spec)
+				ok.MustNoError(t, err)
+				_, err = client.Check(ctx, request)
+				const want = `Failed with code unknown: parsing options: invalid language given "python" for unescapable_keywords_only_languages, expected one of:`
+				ok.ErrorContains(t, err, want)
+			})
+			t.Run("valid", func(t *testing.T) {
+				requestSpec := newRequestSpec(
+					"testdata/unescapable",
+					[]string{"unescapable.proto"},
+					map[string]any{
+						"enabled_languages":                   []string{"rust"},
+						"unescapable_keywords_only_languages": []string{"rust"},
+					},
+				)
+				want := []checktest.ExpectedAnnotation{
+					{
+						RuleID:  ruleIDFieldNoLanguageReservedKeywords,
+						Message: `Field name "self" should not use Rust reserved keyword "self".`,
+						FileLocation: &checktest.ExpectedFileLocation{
+							FileName:    "unescapable.proto",
+							StartLine:   6,
+							StartColumn: 2,
+							EndLine:     6,
+							EndColumn:   18,
+						},
+					},
+				}
+				runCheckTest(t, requestSpec, want...)
+			})
+			t.Run("go", func(t *testing.T) {
+				requestSpec := newRequestSpec(
+					"testdata/go",
+					[]string{"go.proto"},
+					map[string]any{
+						"enabled_languages":                   []string{"go"},
+						"unescapable_keywords_only_languages": []string{"go"},
+					},
+				)
+				want := []checktest.ExpectedAnnotation{
+					{
+						RuleID:  ruleIDPackageNoLanguageReservedKeywords,
+						Message: `Package name "select.v1" should not use Go reserved keyword "select".`,
+						FileLocation: &checktest.ExpectedFileLocation{
+							FileName:    "go.proto",
+							StartLine:   2,
+							StartColumn: 0,
+							EndLine:     2,
+							EndColumn:   18,
+						},
+					},
+				}
+				runCheckTest(t, requestSpec, want...)
+			})
+			t.Run("swift", func(t *testing.T) {
+				requestSpec := newRequestSpec(
+					"testdata/swift_protobuf",
+					[]string{"swift_protobuf.proto"},
+					map[string]any{
+						"enabled_languages":                   []string{"swift"},
+						"unescapable_keywords_only_languages": []string{"swift"},
+					},
+				)
+				want := []checktest.ExpectedAnnotation{
+					{
+						RuleID:  ruleIDEnumValueNoLanguageReservedKeywords,
+						Message: `Enum value name "init" should not use Swift reserved name "init", which SwiftProtobuf renames to "init_".`,
+						FileLocation: &checktest.ExpectedFileLocation{
+							FileName:    "swift_protobuf.proto",
+							StartLine:   12,
+							StartColumn: 2,
+							EndLine:     12,
+							EndColumn:   11,
+						},
+					},
+					{
+						RuleID:  ruleIDEnumValueNoLanguageReservedKeywords,
+						Message: `Enum value name "COLOR_DESCRIPTION" should not use Swift reserved name "description", which SwiftProtobuf renames to "description_".`,
+						FileLocation: &checktest.ExpectedFileLocation{
+							FileName:    "swift_protobuf.proto",
+							StartLine:   13,
+							StartColumn: 2,
+							EndLine:     13,
+							EndColumn:   24,
+						},
+					},
+					{
+						RuleID:  ruleIDEnumValueNoLanguageReservedKeywords,
+						Message: `Enum value name "COLOR_RAW_VALUE" should not use Swift reserved name "rawValue", which SwiftProtobuf renames to "rawValue_".`,
+						FileLocation: &checktest.ExpectedFileLocation{
+							FileName:    "swift_protobuf.proto",
+							StartLine:   14,
+							StartColumn: 2,
+							EndLine:     14,
+							EndColumn:   22,
+						},
+					},
+					{
+						RuleID:  ruleIDEnumValueNoLanguageReservedKeywords,
+						Message: `Enum value name "COLOR_SELF" should not use Swift reserved name "self", which SwiftProtobuf renames to "self_".`,
+						FileLocation: &checktest.ExpectedFileLocation{
+							FileName:    "swift_protobuf.proto",
+							StartLine:   15,
+							StartColumn: 2,
+							EndLine:     15,
+							EndColumn:   17,
+						},
+					},
+					{
+						RuleID:  ruleIDFieldNoLanguageReservedKeywords,
+						Message: `Field name "self" should not use Swift reserved name "self", which SwiftProtobuf renames to "self_p".`,
+						FileLocation: &checktest.ExpectedFileLocation{
+							FileName:    "swift_protobuf.proto",
+							StartLine:   5,
+							StartColumn: 2,
+							EndLine:     5,
+							EndColumn:   18,
+						},
+					},
+					{
+						RuleID:  ruleIDFieldNoLanguageReservedKeywords,
+						Message: `Field name "debug_description" should not use Swift reserved name "debugDescription", which SwiftProtobuf renames to "debugDescription_p".`,
+						FileLocation: &checktest.ExpectedFileLocation{
+							FileName:    "swift_protobuf.proto",
+							StartLine:   7,
+							StartColumn: 2,
+							EndLine:     7,
+							EndColumn:   31,
+						},
+					},
+					{
+						RuleID:  ruleIDMessageNoLanguageReservedKeywords,
+						Message: `Message name "Type" should not use Swift reserved name "Type", which SwiftProtobuf renames to "TypeMessage".`,
+						FileLocation: &checktest.ExpectedFileLocation{
+							FileName:    "swift_protobuf.proto",
+							StartLine:   4,
+							StartColumn: 0,
+							EndLine:     8,
+							EndColumn:   1,
+						},
+					},
+				}
+				runCheckTest(t, requestSpec, want...)
 			})
 		})
 	})